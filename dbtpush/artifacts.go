@@ -0,0 +1,78 @@
+package dbtpush
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ManifestFile is the only artifact required to push a dbt Core run
+const ManifestFile = "manifest.json"
+
+// TargetDirArtifacts are the artifacts read from a dbt target directory, when present
+var TargetDirArtifacts = []string{ManifestFile, "catalog.json", "run_results.json"}
+
+// Artifact is a dbt artifact file to upload to a dbt Core integration
+type Artifact struct {
+	// Name is the file name of the artifact, e.g. manifest.json
+	Name    string
+	Content []byte
+}
+
+// ReadTargetDir reads the artifacts from a dbt target directory. manifest.json is required,
+// catalog.json and run_results.json are read when present.
+func ReadTargetDir(dir string) ([]Artifact, error) {
+	var paths []string
+	for _, name := range TargetDirArtifacts {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err != nil {
+			if errors.Is(err, os.ErrNotExist) && name != ManifestFile {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read dbt target directory: %w", err)
+		}
+		paths = append(paths, path)
+	}
+
+	return ReadFiles(paths...)
+}
+
+// ReadFiles reads the artifacts from the given paths. Artifacts are named after their file
+// name, which must be unique.
+func ReadFiles(paths ...string) ([]Artifact, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("no artifacts to upload")
+	}
+
+	artifacts := make([]Artifact, 0, len(paths))
+	seen := map[string]bool{}
+	for _, path := range paths {
+		name := filepath.Base(path)
+		if seen[name] {
+			return nil, fmt.Errorf("artifact file name %q is used more than once", name)
+		}
+		seen[name] = true
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read artifact: %w", err)
+		}
+		artifacts = append(artifacts, Artifact{Name: name, Content: content})
+	}
+
+	return artifacts, nil
+}
+
+// Hash returns the hex encoded SHA-256 hash of each artifact, by name
+func Hash(artifacts []Artifact) map[string]string {
+	hashes := make(map[string]string, len(artifacts))
+	for _, artifact := range artifacts {
+		sum := sha256.Sum256(artifact.Content)
+		hashes[artifact.Name] = hex.EncodeToString(sum[:])
+	}
+
+	return hashes
+}
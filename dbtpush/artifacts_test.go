@@ -0,0 +1,70 @@
+package dbtpush
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadTargetDir(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %s", name, err)
+		}
+	}
+
+	if _, err := ReadTargetDir(dir); err == nil {
+		t.Error("expected error for target directory without manifest.json")
+	}
+
+	writeFile("manifest.json", `{"nodes":{}}`)
+	writeFile("run_results.json", `{"results":[]}`)
+	writeFile("graph.gpickle", "ignored")
+
+	artifacts, err := ReadTargetDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var names []string
+	for _, artifact := range artifacts {
+		names = append(names, artifact.Name)
+	}
+	if expected := []string{"manifest.json", "run_results.json"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected artifacts %v, got %v", expected, names)
+	}
+}
+
+func TestReadFiles(t *testing.T) {
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, "manifest.json")
+	if err := os.WriteFile(manifest, []byte("{}"), 0o600); err != nil {
+		t.Fatalf("failed to write manifest: %s", err)
+	}
+
+	if _, err := ReadFiles(); err == nil {
+		t.Error("expected error without files")
+	}
+	if _, err := ReadFiles(manifest, filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("expected error for missing file")
+	}
+
+	duplicate := filepath.Join(t.TempDir(), "manifest.json")
+	if err := os.WriteFile(duplicate, []byte("{}"), 0o600); err != nil {
+		t.Fatalf("failed to write duplicate: %s", err)
+	}
+	if _, err := ReadFiles(manifest, duplicate); err == nil {
+		t.Error("expected error for duplicate file names")
+	}
+}
+
+func TestHash(t *testing.T) {
+	hashes := Hash([]Artifact{{Name: "manifest.json", Content: []byte("{}")}})
+
+	// sha256 of "{}"
+	if expected := "44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a"; hashes["manifest.json"] != expected {
+		t.Errorf("expected hash %s, got %s", expected, hashes["manifest.json"])
+	}
+}
@@ -0,0 +1,257 @@
+// Package dbtpush implements the push protocol of Euno dbt Core integrations. dbt artifacts
+// are uploaded to the trigger URL of the integration, authenticated with its trigger secret.
+package dbtpush
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default settings of a new Client. DefaultTimeout, DefaultRetryWaitMin and DefaultRetryWaitMax
+// also apply when the corresponding fields of a Client are not set.
+const (
+	DefaultTimeout      = 5 * time.Minute
+	DefaultMaxRetries   = 3
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// defaultHTTPClient sends the upload requests of clients without an HTTPClient
+var defaultHTTPClient = &http.Client{Timeout: DefaultTimeout}
+
+// Client uploads dbt artifacts to the trigger URL of a dbt Core integration. A Client with
+// only TriggerURL and TriggerSecret set is ready to use: it sends requests with an HTTP client
+// timing out after DefaultTimeout and does not retry failed uploads. Use NewClient for
+// a client that retries.
+type Client struct {
+	TriggerURL    string
+	TriggerSecret string
+
+	// HTTPClient sends the upload requests. When nil, an HTTP client with DefaultTimeout is used.
+	HTTPClient *http.Client
+	// Gzip compresses the request body with Content-Encoding: gzip
+	Gzip bool
+	// MaxRetries is the number of times a failed upload is retried. Network errors, 408, 429
+	// and 5xx responses are retried.
+	MaxRetries int
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between attempts. A
+	// Retry-After header on the response takes precedence, up to RetryWaitMax. When zero,
+	// DefaultRetryWaitMin and DefaultRetryWaitMax are used.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+}
+
+// UploadResult reports the status of a completed upload
+type UploadResult struct {
+	// RunID is the ID of the run triggered by the upload, empty when not reported
+	RunID string
+	// StatusCode is the HTTP status of the successful response
+	StatusCode int
+	// Attempts is the number of requests sent, including the successful one
+	Attempts int
+}
+
+// UploadError is returned when the trigger endpoint rejects an upload
+type UploadError struct {
+	StatusCode int
+	Body       string
+}
+
+// Error implements the error interface
+func (e *UploadError) Error() string {
+	return fmt.Sprintf("upload failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// NewClient creates a new Client with the default retry settings
+func NewClient(triggerURL, triggerSecret string) *Client {
+	return &Client{
+		TriggerURL:    triggerURL,
+		TriggerSecret: triggerSecret,
+		HTTPClient:    &http.Client{Timeout: DefaultTimeout},
+		MaxRetries:    DefaultMaxRetries,
+		RetryWaitMin:  DefaultRetryWaitMin,
+		RetryWaitMax:  DefaultRetryWaitMax,
+	}
+}
+
+// Upload posts the artifacts as multipart form data, with one part per artifact named after
+// its file name. Failed attempts are retried until MaxRetries is reached or ctx is done.
+func (c *Client) Upload(ctx context.Context, artifacts []Artifact) (*UploadResult, error) {
+	if len(artifacts) == 0 {
+		return nil, errors.New("no artifacts to upload")
+	}
+
+	body, contentType, err := c.encode(artifacts)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		result, retryAfter, err := c.send(ctx, body, contentType)
+		if err == nil {
+			result.Attempts = attempt
+			return result, nil
+		}
+
+		if retryAfter < 0 || attempt > c.MaxRetries {
+			return nil, fmt.Errorf("upload attempt %d: %w", attempt, err)
+		}
+
+		wait := c.backoff(attempt, retryAfter)
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("upload attempt %d: %w (giving up: %w)", attempt, err, ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+}
+
+// encode builds the request body, which is sent unchanged on every attempt
+func (c *Client) encode(artifacts []Artifact) ([]byte, string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	for _, artifact := range artifacts {
+		part, err := writer.CreateFormFile(artifact.Name, artifact.Name)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to encode artifact %s: %w", artifact.Name, err)
+		}
+		if _, err := part.Write(artifact.Content); err != nil {
+			return nil, "", fmt.Errorf("failed to encode artifact %s: %w", artifact.Name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to encode artifacts: %w", err)
+	}
+
+	if !c.Gzip {
+		return buf.Bytes(), writer.FormDataContentType(), nil
+	}
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	if _, err := gz.Write(buf.Bytes()); err != nil {
+		return nil, "", fmt.Errorf("failed to compress artifacts: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to compress artifacts: %w", err)
+	}
+
+	return compressed.Bytes(), writer.FormDataContentType(), nil
+}
+
+// send makes a single upload attempt. retryAfter is negative when the error is not
+// retryable, and otherwise the delay requested by the server, if any.
+func (c *Client) send(ctx context.Context, body []byte, contentType string) (*UploadResult, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.TriggerURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, -1, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.TriggerSecret)
+	req.Header.Set("Content-Type", contentType)
+	if c.Gzip {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, -1, fmt.Errorf("failed to make request: %w", err)
+		}
+		return nil, 0, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		uploadErr := &UploadError{StatusCode: resp.StatusCode, Body: string(respBody)}
+		if !retryableStatus(resp.StatusCode) {
+			return nil, -1, uploadErr
+		}
+		return nil, parseRetryAfter(resp.Header.Get("Retry-After")), uploadErr
+	}
+
+	return &UploadResult{
+		RunID:      parseRunID(respBody),
+		StatusCode: resp.StatusCode,
+	}, 0, nil
+}
+
+// backoff returns the delay before the next attempt
+func (c *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	waitMin, waitMax := c.RetryWaitMin, c.RetryWaitMax
+	if waitMin <= 0 {
+		waitMin = DefaultRetryWaitMin
+	}
+	if waitMax <= 0 {
+		waitMax = DefaultRetryWaitMax
+	}
+
+	wait := retryAfter
+	if wait == 0 {
+		wait = waitMin << (attempt - 1)
+	}
+	if wait > waitMax || wait < 0 {
+		wait = waitMax
+	}
+
+	return wait
+}
+
+// retryableStatus returns whether a response status is worth retrying
+func retryableStatus(status int) bool {
+	return status == http.StatusRequestTimeout ||
+		status == http.StatusTooManyRequests ||
+		(status >= 500 && status != http.StatusNotImplemented)
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// parseRunID extracts the run ID from the trigger response, which may be a string or a number
+func parseRunID(body []byte) string {
+	var result struct {
+		RunID json.RawMessage `json:"run_id"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil || len(result.RunID) == 0 {
+		return ""
+	}
+
+	var runID string
+	if err := json.Unmarshal(result.RunID, &runID); err == nil {
+		return runID
+	}
+
+	var numericID int64
+	if err := json.Unmarshal(result.RunID, &numericID); err == nil {
+		return strconv.FormatInt(numericID, 10)
+	}
+
+	return ""
+}
@@ -0,0 +1,216 @@
+package dbtpush
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testArtifacts = []Artifact{
+	{Name: "manifest.json", Content: []byte(`{"nodes":{}}`)},
+	{Name: "catalog.json", Content: []byte(`{"sources":{}}`)},
+}
+
+// newTestClient returns a client for the server that retries without waiting
+func newTestClient(server *httptest.Server) *Client {
+	client := NewClient(server.URL, "secret")
+	client.HTTPClient = server.Client()
+	client.RetryWaitMin = time.Millisecond
+	client.RetryWaitMax = time.Millisecond
+	return client
+}
+
+// checkArtifacts verifies the authorization and artifacts of an upload request
+func checkArtifacts(t *testing.T, r *http.Request) {
+	t.Helper()
+
+	if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+		t.Errorf("expected bearer authorization, got %q", got)
+	}
+	for _, artifact := range testArtifacts {
+		file, _, err := r.FormFile(artifact.Name)
+		if err != nil {
+			t.Errorf("expected part %s: %s", artifact.Name, err)
+			continue
+		}
+		content, _ := io.ReadAll(file)
+		if string(content) != string(artifact.Content) {
+			t.Errorf("expected %s content %s, got %s", artifact.Name, artifact.Content, content)
+		}
+	}
+}
+
+func TestUpload(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		response      string
+		expectedRunID string
+		expectError   bool
+	}{
+		{name: "string run id", status: http.StatusOK, response: `{"run_id": "run-1"}`, expectedRunID: "run-1"},
+		{name: "numeric run id", status: http.StatusAccepted, response: `{"run_id": 42}`, expectedRunID: "42"},
+		{name: "no run id", status: http.StatusOK, response: `ok`},
+		{name: "unauthorized", status: http.StatusUnauthorized, response: `{"detail": "invalid secret"}`, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				checkArtifacts(t, r)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			result, err := newTestClient(server).Upload(context.Background(), testArtifacts)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error %t, got %v", tt.expectError, err)
+			}
+			if requests.Load() != 1 {
+				t.Errorf("expected a single request, got %d", requests.Load())
+			}
+			if tt.expectError {
+				var uploadErr *UploadError
+				if !errors.As(err, &uploadErr) || uploadErr.StatusCode != tt.status {
+					t.Errorf("expected UploadError with status %d, got %v", tt.status, err)
+				}
+				return
+			}
+			if result.RunID != tt.expectedRunID {
+				t.Errorf("expected run ID %q, got %q", tt.expectedRunID, result.RunID)
+			}
+			if result.StatusCode != tt.status || result.Attempts != 1 {
+				t.Errorf("expected status %d after 1 attempt, got %d after %d", tt.status, result.StatusCode, result.Attempts)
+			}
+		})
+	}
+}
+
+func TestUploadRetries(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkArtifacts(t, r)
+		switch requests.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			_, _ = w.Write([]byte(`{"run_id": "run-3"}`))
+		}
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server).Upload(context.Background(), testArtifacts)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.Attempts != 3 || result.RunID != "run-3" {
+		t.Errorf("expected run-3 after 3 attempts, got %q after %d", result.RunID, result.Attempts)
+	}
+}
+
+func TestUploadGivesUp(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.MaxRetries = 2
+
+	if _, err := client.Upload(context.Background(), testArtifacts); err == nil {
+		t.Fatal("expected error")
+	}
+	if requests.Load() != 3 {
+		t.Errorf("expected 3 requests, got %d", requests.Load())
+	}
+
+	// A cancelled context stops retrying
+	client.MaxRetries = 100
+	client.RetryWaitMin, client.RetryWaitMax = time.Hour, time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.Upload(ctx, testArtifacts); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestUploadZeroValueClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkArtifacts(t, r)
+		_, _ = w.Write([]byte(`{"run_id": 42}`))
+	}))
+	defer server.Close()
+
+	client := &Client{TriggerURL: server.URL, TriggerSecret: "secret"}
+	result, err := client.Upload(context.Background(), testArtifacts)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.Attempts != 1 || result.RunID != "42" {
+		t.Errorf("expected run 42 after 1 attempt, got %q after %d", result.RunID, result.Attempts)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name       string
+		client     Client
+		attempt    int
+		retryAfter time.Duration
+		expected   time.Duration
+	}{
+		{name: "zero value first attempt", attempt: 1, expected: DefaultRetryWaitMin},
+		{name: "zero value later attempt", attempt: 3, expected: 4 * DefaultRetryWaitMin},
+		{name: "zero value capped", attempt: 10, expected: DefaultRetryWaitMax},
+		{name: "configured", client: Client{RetryWaitMin: time.Second, RetryWaitMax: 3 * time.Second}, attempt: 2, expected: 2 * time.Second},
+		{name: "configured capped", client: Client{RetryWaitMin: time.Second, RetryWaitMax: 3 * time.Second}, attempt: 3, expected: 3 * time.Second},
+		{name: "retry after", client: Client{RetryWaitMin: time.Second, RetryWaitMax: time.Minute}, attempt: 1, retryAfter: 10 * time.Second, expected: 10 * time.Second},
+		{name: "retry after capped", attempt: 1, retryAfter: time.Hour, expected: DefaultRetryWaitMax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := tt.client.backoff(tt.attempt, tt.retryAfter); actual != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestUploadGzip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") != "gzip" {
+			t.Errorf("expected gzip content encoding, got %q", r.Header.Get("Content-Encoding"))
+		}
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Errorf("failed to read gzip body: %s", err)
+			return
+		}
+		r.Body = gz
+		checkArtifacts(t, r)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.Gzip = true
+
+	if _, err := client.Upload(context.Background(), testArtifacts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
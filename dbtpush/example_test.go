@@ -0,0 +1,27 @@
+package dbtpush_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/euno-ai/terraform-provider-euno/dbtpush"
+)
+
+func ExampleClient_Upload() {
+	artifacts, err := dbtpush.ReadTargetDir("target")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client := dbtpush.NewClient(os.Getenv("EUNO_TRIGGER_URL"), os.Getenv("EUNO_TRIGGER_SECRET"))
+	client.Gzip = true
+
+	result, err := client.Upload(context.Background(), artifacts)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("triggered run %s after %d attempt(s)\n", result.RunID, result.Attempts)
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccountDataSource{}
+var _ datasource.DataSourceWithConfigure = &AccountDataSource{}
+
+// NewAccountDataSource is a helper function to simplify the provider implementation.
+func NewAccountDataSource() datasource.DataSource {
+	return &AccountDataSource{}
+}
+
+// AccountDataSource defines the data source implementation.
+type AccountDataSource struct {
+	client *EunoClient
+}
+
+// AccountDataSourceModel describes the data source data model.
+type AccountDataSourceModel struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Plan                    types.String `tfsdk:"plan"`
+	EnabledIntegrationTypes types.List   `tfsdk:"enabled_integration_types"`
+}
+
+// Metadata returns the data source type name.
+func (d *AccountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account"
+}
+
+// Schema defines the schema for the data source.
+func (d *AccountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the Euno account the provider is configured with",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the account",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the account",
+			},
+			"plan": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The plan of the account",
+			},
+			"enabled_integration_types": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The integration types enabled for the account, such as `snowflake` or `dbt_core`",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *AccountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EunoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EunoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *AccountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccountDataSourceModel
+
+	result, err := d.client.GetAccount(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read account", err)
+		return
+	}
+
+	// Map the response to the model
+	data.ID = types.Int64Value(int64(result.ID))
+	data.Name = types.StringValue(result.Name)
+	data.Plan = types.StringValue(result.Plan)
+
+	enabledTypes := make([]attr.Value, len(result.EnabledIntegrationTypes))
+	for i, integrationType := range result.EnabledIntegrationTypes {
+		enabledTypes[i] = types.StringValue(integrationType)
+	}
+	data.EnabledIntegrationTypes = types.ListValueMust(types.StringType, enabledTypes)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
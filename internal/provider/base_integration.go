@@ -2,16 +2,25 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // BaseIntegrationResourceModel contains the common fields for all integration resources
@@ -26,6 +35,21 @@ type BaseIntegrationResourceModel struct {
 	PendingCredentialsLookupKey types.String               `tfsdk:"pending_credentials_lookup_key"`
 	LastUpdatedAt               types.String               `tfsdk:"last_updated_at"`
 	CreatedAt                   types.String               `tfsdk:"created_at"`
+	WaitForFirstRun             types.Bool                 `tfsdk:"wait_for_first_run"`
+	Timeouts                    timeouts.Value             `tfsdk:"timeouts"`
+	IntegrationStatusModel
+}
+
+// IntegrationStatusModel describes the computed run status and audit metadata of an integration
+type IntegrationStatusModel struct {
+	LastRunStatus            types.String `tfsdk:"last_run_status"`
+	Health                   types.String `tfsdk:"health"`
+	LastCompletedRunEndTime  types.String `tfsdk:"last_completed_run_end_time"`
+	TriggerType              types.String `tfsdk:"trigger_type"`
+	LastTimeTriggered        types.String `tfsdk:"last_time_triggered"`
+	CreatedBy                types.String `tfsdk:"created_by"`
+	LastUpdatedBy            types.String `tfsdk:"last_updated_by"`
+	CollectedIntegrationData types.String `tfsdk:"collected_integration_data"`
 }
 
 // ScheduleModel describes the schedule configuration
@@ -42,9 +66,20 @@ type InvalidationStrategyModel struct {
 	TTLDays    types.Int64 `tfsdk:"ttl_days"`
 }
 
+// Default operation timeouts, used when the timeouts block does not set them.
+// Create and update include waiting for the first run when requested.
+const (
+	defaultCreateTimeout = 30 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 30 * time.Minute
+	defaultDeleteTimeout = 5 * time.Minute
+)
+
 // BaseIntegrationResource provides common functionality for all integration resources
 type BaseIntegrationResource struct {
 	client *EunoClient
+	// integrationType is the integration_type of the integrations managed by the resource
+	integrationType string
 }
 
 // Configure adds the provider configured client to the resource.
@@ -68,9 +103,76 @@ func (r *BaseIntegrationResource) Configure(ctx context.Context, req resource.Co
 	r.client = client
 }
 
+// waitForFirstRun triggers a run of the integration and waits for it to complete,
+// refreshing the run status attributes of the model with the outcome.
+func (r *BaseIntegrationResource) waitForFirstRun(ctx context.Context, data *BaseIntegrationResourceModel, integrationName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	integration := &IntegrationOut{
+		ID:                      int(data.ID.ValueInt64()),
+		LastCompletedRunEndTime: data.LastCompletedRunEndTime.ValueStringPointer(),
+	}
+
+	result, err := runIntegrationAndWait(ctx, r.client, integration)
+	if result != nil {
+		data.IntegrationStatusModel = convertStatusFromAPI(result)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		addClientError(&diags, fmt.Sprintf("Unable to wait for %s integration %q to complete a run", integrationName, data.Name.ValueString()), err)
+	} else if err != nil {
+		diags.AddError("Integration Run Error", fmt.Sprintf("%s integration %q did not complete a successful run: %s", integrationName, data.Name.ValueString(), err))
+	}
+
+	return diags
+}
+
+// addClientError adds the diagnostic for a failed API call, explaining when the
+// operation ran out of time or the credentials of the provider were rejected.
+func addClientError(diags *diag.Diagnostics, operation string, err error) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		diags.AddError(
+			"Timeout Exceeded",
+			fmt.Sprintf("%s: the operation did not complete within its timeout (%s). The timeout can be increased in the resource's timeouts block.", operation, err),
+		)
+		return
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized:
+			diags.AddError(
+				"Invalid Euno API Key",
+				fmt.Sprintf("%s: the API key was rejected (%s). Check the api_key of the provider, and set validate_credentials to true to verify the credentials before any request.", operation, err),
+			)
+			return
+		case http.StatusForbidden:
+			diags.AddError(
+				"Euno Account Access Denied",
+				fmt.Sprintf("%s: the API key does not have access to the account (%s). Check the account_id and api_key of the provider, and set validate_credentials to true to verify the credentials before any request.", operation, err),
+			)
+			return
+		case http.StatusNotFound:
+			diags.AddError(
+				"Euno Account Not Found",
+				fmt.Sprintf("%s: the account was not found (%s). Check the account_id and server_url of the provider.", operation, err),
+			)
+			return
+		}
+	}
+
+	diags.AddError("Client Error", fmt.Sprintf("%s, got error: %s", operation, err))
+}
+
 // getCommonBlocks returns the common blocks for pull integration resources
-func getCommonBlocks() map[string]schema.Block {
+func getCommonBlocks(ctx context.Context) map[string]schema.Block {
 	return map[string]schema.Block{
+		"timeouts": timeouts.Block(ctx, timeouts.Opts{
+			Create: true,
+			Read:   true,
+			Update: true,
+			Delete: true,
+		}),
 		"schedule": schema.SingleNestedBlock{
 			MarkdownDescription: "The schedule configuration for the integration",
 			Attributes: map[string]schema.Attribute{
@@ -110,8 +212,14 @@ func getCommonBlocks() map[string]schema.Block {
 }
 
 // getCommonBlocksForPush returns the common blocks for push integration resources (no schedule)
-func getCommonBlocksForPush() map[string]schema.Block {
+func getCommonBlocksForPush(ctx context.Context) map[string]schema.Block {
 	return map[string]schema.Block{
+		"timeouts": timeouts.Block(ctx, timeouts.Opts{
+			Create: true,
+			Read:   true,
+			Update: true,
+			Delete: true,
+		}),
 		"invalidation_strategy": schema.SingleNestedBlock{
 			MarkdownDescription: "The invalidation strategy configuration",
 			Attributes: map[string]schema.Attribute{
@@ -168,6 +276,7 @@ func convertScheduleFromAPI(apiSchedule *IntegrationSchedule) *ScheduleModel {
 
 	schedule := &ScheduleModel{
 		TimeZone: types.StringValue(apiSchedule.TimeZone),
+		RepeatOn: types.ListNull(types.StringType),
 	}
 
 	if apiSchedule.RepeatOn != nil {
@@ -224,23 +333,168 @@ func convertInvalidationStrategyFromAPI(apiStrategy *InvalidationStrategy) *Inva
 	return strategy
 }
 
-// ImportState imports the resource from the API.
+// convertStatusFromAPI converts the API run status and audit metadata to Terraform format
+func convertStatusFromAPI(result *IntegrationOut) IntegrationStatusModel {
+	status := IntegrationStatusModel{
+		LastRunStatus:            types.StringPointerValue(result.LastRunStatus),
+		Health:                   types.StringPointerValue(result.Health),
+		LastCompletedRunEndTime:  types.StringPointerValue(result.LastCompletedRunEndTime),
+		TriggerType:              types.StringPointerValue(result.TriggerType),
+		LastTimeTriggered:        types.StringPointerValue(result.LastTimeTriggered),
+		CreatedBy:                types.StringValue(result.CreatedBy),
+		LastUpdatedBy:            types.StringValue(result.LastUpdatedBy),
+		CollectedIntegrationData: types.StringNull(),
+	}
+
+	// The collected data is free-form, so it is exposed as a JSON document
+	if result.CollectedIntegrationData != nil {
+		if collected, err := json.Marshal(result.CollectedIntegrationData); err == nil {
+			status.CollectedIntegrationData = types.StringValue(string(collected))
+		}
+	}
+
+	return status
+}
+
+// integrationImportID is an import ID parsed by parseIntegrationImportID. Either ID or Name is set.
+type integrationImportID struct {
+	// AccountID is zero when the import ID does not name an account
+	AccountID int
+	ID        int
+	Name      string
+}
+
+// parseIntegrationImportID parses the import ID of an integration resource, which is either
+// the integration ID, <account_id>/<id> or name:<name>
+func parseIntegrationImportID(importID string) (*integrationImportID, error) {
+	if name, ok := strings.CutPrefix(importID, "name:"); ok {
+		if name == "" {
+			return nil, errors.New("the name after name: must not be empty")
+		}
+		return &integrationImportID{Name: name}, nil
+	}
+
+	parsed := &integrationImportID{}
+	id := importID
+	if account, rest, ok := strings.Cut(importID, "/"); ok {
+		accountID, err := strconv.Atoi(account)
+		if err != nil || accountID < 1 {
+			return nil, fmt.Errorf("%q is not a valid account ID", account)
+		}
+		parsed.AccountID = accountID
+		id = rest
+	}
+
+	integrationID, err := strconv.Atoi(id)
+	if err != nil || integrationID < 1 {
+		return nil, fmt.Errorf("%q is not a valid integration ID", id)
+	}
+	parsed.ID = integrationID
+
+	return parsed, nil
+}
+
+// ImportState imports the resource from the API. The integration is looked up to resolve
+// names and to check that it has the integration type of the resource.
 func (r *BaseIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// The import ID is just the integration ID since account_id is in the provider
-	integrationID := req.ID
-	if integrationID == "" {
-		resp.Diagnostics.AddError("Invalid import ID", "Import ID must be the integration ID")
+	importID, err := parseIntegrationImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be the integration ID, <account_id>/<id> or name:<name>, got %q: %s.", req.ID, err),
+		)
 		return
 	}
 
-	// Convert string ID to Int64
-	id, err := strconv.ParseInt(integrationID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse integration ID %q: %s", integrationID, err))
+	if importID.AccountID != 0 && importID.AccountID != r.client.accountID {
+		resp.Diagnostics.AddError(
+			"Account Mismatch",
+			fmt.Sprintf("Integration %d belongs to account %d, but the provider is configured for account %d.", importID.ID, importID.AccountID, r.client.accountID),
+		)
+		return
+	}
+
+	var integration *IntegrationOut
+	if importID.Name != "" {
+		integrations, err := r.client.ListIntegrations(ctx, r.integrationType)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Unable to list integrations", err)
+			return
+		}
+
+		integration, err = findIntegrationByName(integrations, importID.Name, r.integrationType)
+		if err != nil {
+			resp.Diagnostics.AddError("Integration Not Found", err.Error())
+			return
+		}
+	} else {
+		integration, err = r.client.GetIntegration(ctx, importID.ID)
+		if err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read integration %d", importID.ID), err)
+			return
+		}
+	}
+
+	if integration.IntegrationType != r.integrationType {
+		resp.Diagnostics.AddError(
+			"Integration Type Mismatch",
+			fmt.Sprintf("Integration %d is a %s integration and cannot be imported as a %s integration.", integration.ID, integration.IntegrationType, r.integrationType),
+		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(id))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(int64(integration.ID)))...)
+
+	// The configuration block is read into a struct, which cannot hold a null block, so it is
+	// imported with null attributes for Read to fill in
+	configurationType, diags := resp.State.Schema.TypeAtPath(ctx, path.Root("configuration"))
+	if diags.HasError() {
+		return
+	}
+
+	configuration, diags := nullAttributesObject(ctx, configurationType)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("configuration"), configuration)...)
+}
+
+// nullAttributesObject returns a known object of the given object type with all attributes null
+func nullAttributesObject(ctx context.Context, objectType attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfType, ok := objectType.TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		diags.AddError("Unexpected Object Type", fmt.Sprintf("Expected an object type, got %s.", objectType))
+		return nil, diags
+	}
+
+	attributes := make(map[string]tftypes.Value, len(tfType.AttributeTypes))
+	for name, attributeType := range tfType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	value, err := objectType.ValueFromTerraform(ctx, tftypes.NewValue(tfType, attributes))
+	if err != nil {
+		diags.AddError("Unable to Build Object", err.Error())
+		return nil, diags
+	}
+
+	return value, diags
+}
+
+// getCommonAttributesForPull returns the common attributes for pull integration resources
+func getCommonAttributesForPull() map[string]schema.Attribute {
+	attrs := getCommonAttributes()
+	attrs["wait_for_first_run"] = schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Whether create and update should trigger a run and wait for it to complete. A failed run is reported as an apply error",
+	}
+
+	return attrs
 }
 
 // getCommonAttributes returns the common attributes for all integration resources
@@ -271,6 +525,9 @@ func getCommonAttributes() map[string]schema.Attribute {
 			Computed:            true,
 			Sensitive:           true,
 			MarkdownDescription: "The URL for triggering the integration",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
 		},
 		"pending_credentials_lookup_key": schema.StringAttribute{
 			Optional:            true,
@@ -284,5 +541,88 @@ func getCommonAttributes() map[string]schema.Attribute {
 			Computed:            true,
 			MarkdownDescription: "The creation timestamp",
 		},
+		"created_by": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The user who created the integration",
+		},
+		"last_updated_by": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The user who last updated the integration",
+		},
+		"last_run_status": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The status of the last integration run",
+		},
+		"health": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The health of the integration",
+		},
+		"last_completed_run_end_time": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The end timestamp of the last completed run",
+		},
+		"trigger_type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "How the last run was triggered",
+		},
+		"last_time_triggered": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The timestamp the integration was last triggered",
+		},
+		"collected_integration_data": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Data collected by the integration's last run, encoded as JSON. Use `jsondecode` to access its fields",
+		},
 	}
 }
+
+// convertStringListToAPI converts a Terraform list of strings to API format
+func convertStringListToAPI(list types.List) []string {
+	values := make([]string, 0, len(list.Elements()))
+	for _, elem := range list.Elements() {
+		if strElem, ok := elem.(types.String); ok {
+			values = append(values, strElem.ValueString())
+		}
+	}
+
+	return values
+}
+
+// convertStringListFromAPI converts a list of strings decoded from an API configuration to
+// Terraform format. Values that are not a list are converted to a null list.
+func convertStringListFromAPI(value interface{}) types.List {
+	items, ok := value.([]interface{})
+	if !ok {
+		return types.ListNull(types.StringType)
+	}
+
+	elems := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			elems = append(elems, types.StringValue(s))
+		}
+	}
+
+	return types.ListValueMust(types.StringType, elems)
+}
+
+// validatePatternList validates each known element of a list of name patterns
+func validatePatternList(list types.List, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, elem := range list.Elements() {
+		pattern, ok := elem.(types.String)
+		if !ok || pattern.IsNull() || pattern.IsUnknown() {
+			continue
+		}
+		if err := validateNamePattern(pattern.ValueString()); err != nil {
+			diags.AddAttributeError(
+				attributePath.AtListIndex(i),
+				"Invalid Pattern",
+				fmt.Sprintf("Unable to parse the pattern: %s", err),
+			)
+		}
+	}
+
+	return diags
+}
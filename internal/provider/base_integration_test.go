@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestParseIntegrationImportID(t *testing.T) {
+	tests := []struct {
+		importID    string
+		expected    integrationImportID
+		expectError bool
+	}{
+		{importID: "123", expected: integrationImportID{ID: 123}},
+		{importID: "1/123", expected: integrationImportID{AccountID: 1, ID: 123}},
+		{importID: "name:prod-snowflake", expected: integrationImportID{Name: "prod-snowflake"}},
+		{importID: "name:team/prod:1", expected: integrationImportID{Name: "team/prod:1"}},
+		{importID: "", expectError: true},
+		{importID: "name:", expectError: true},
+		{importID: "abc", expectError: true},
+		{importID: "0", expectError: true},
+		{importID: "-5", expectError: true},
+		{importID: "1/", expectError: true},
+		{importID: "/123", expectError: true},
+		{importID: "x/123", expectError: true},
+		{importID: "1/2/3", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importID, func(t *testing.T) {
+			actual, err := parseIntegrationImportID(tt.importID)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error %t, got %v", tt.expectError, err)
+			}
+			if err == nil && *actual != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, *actual)
+			}
+		})
+	}
+}
+
+func TestIntegrationResourceTypes(t *testing.T) {
+	dbtCore := NewDbtCoreIntegrationResource().(*DbtCoreIntegrationResource)
+	fivetran := NewFivetranIntegrationResource().(*FivetranIntegrationResource)
+	hex := NewHexIntegrationResource().(*HexIntegrationResource)
+	snowflake := NewSnowflakeIntegrationResource().(*SnowflakeIntegrationResource)
+
+	tests := []struct {
+		resource        resource.Resource
+		base            BaseIntegrationResource
+		integrationType string
+	}{
+		{resource: dbtCore, base: dbtCore.BaseIntegrationResource, integrationType: "dbt_core"},
+		{resource: fivetran, base: fivetran.BaseIntegrationResource, integrationType: "fivetran"},
+		{resource: hex, base: hex.BaseIntegrationResource, integrationType: "hex"},
+		{resource: snowflake, base: snowflake.BaseIntegrationResource, integrationType: "snowflake"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.integrationType, func(t *testing.T) {
+			metadataResp := &resource.MetadataResponse{}
+			tt.resource.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "euno"}, metadataResp)
+
+			// The integration type sent on create and update must match the resource type
+			if expected := "euno_" + tt.integrationType + "_integration"; metadataResp.TypeName != expected {
+				t.Errorf("expected type name %q, got %q", expected, metadataResp.TypeName)
+			}
+			if tt.base.integrationType != tt.integrationType {
+				t.Errorf("expected integration type %q, got %q", tt.integrationType, tt.base.integrationType)
+			}
+		})
+	}
+}
+
+func TestIntegrationImportState(t *testing.T) {
+	ctx := context.Background()
+
+	integrations := []IntegrationOut{
+		{ID: 7, Name: "hex", IntegrationType: "hex"},
+		{ID: 8, Name: "warehouse", IntegrationType: "snowflake"},
+		{ID: 9, Name: "duplicate", IntegrationType: "hex"},
+		{ID: 10, Name: "duplicate", IntegrationType: "hex"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/accounts/1/integrations" {
+			var items []IntegrationOut
+			for _, integration := range integrations {
+				if integration.IntegrationType == r.URL.Query().Get("integration_type") {
+					items = append(items, integration)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(IntegrationPage{Items: items, Total: len(items)})
+			return
+		}
+		for _, integration := range integrations {
+			if r.URL.Path == fmt.Sprintf("/accounts/1/integrations/%d", integration.ID) {
+				_ = json.NewEncoder(w).Encode(integration)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	r := NewHexIntegrationResource().(*HexIntegrationResource)
+	r.client = NewEunoClient(server.URL, "key", 1)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		importID        string
+		expectedID      int64
+		expectedSummary string
+	}{
+		{importID: "7", expectedID: 7},
+		{importID: "1/7", expectedID: 7},
+		{importID: "name:hex", expectedID: 7},
+		{importID: "2/7", expectedSummary: "Account Mismatch"},
+		{importID: "8", expectedSummary: "Integration Type Mismatch"},
+		{importID: "name:warehouse", expectedSummary: "Integration Not Found"},
+		{importID: "name:duplicate", expectedSummary: "Integration Not Found"},
+		{importID: "99", expectedSummary: "Client Error"},
+		{importID: "hex", expectedSummary: "Invalid Import ID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importID, func(t *testing.T) {
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.importID}, resp)
+
+			if tt.expectedSummary != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.expectedSummary {
+					t.Fatalf("expected error %q, got %v", tt.expectedSummary, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var id types.Int64
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			if id.ValueInt64() != tt.expectedID {
+				t.Errorf("expected id %d, got %s", tt.expectedID, id)
+			}
+		})
+	}
+}
+
+func TestAddClientError(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          int
+		err             error
+		expectedSummary string
+	}{
+		{name: "unauthorized", status: http.StatusUnauthorized, expectedSummary: "Invalid Euno API Key"},
+		{name: "forbidden", status: http.StatusForbidden, expectedSummary: "Euno Account Access Denied"},
+		{name: "account not found", status: http.StatusNotFound, expectedSummary: "Euno Account Not Found"},
+		{name: "server error", status: http.StatusInternalServerError, expectedSummary: "Client Error"},
+		{name: "network error", err: errors.New("failed to make request: connection refused"), expectedSummary: "Client Error"},
+		{name: "timeout", err: fmt.Errorf("failed to make request: %w", context.DeadlineExceeded), expectedSummary: "Timeout Exceeded"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.err
+			if err == nil {
+				// Go through the client, so that the error is the one returned for resource calls
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(`{"detail": "rejected"}`))
+				}))
+				defer server.Close()
+
+				_, err = NewEunoClient(server.URL, "key", 1).CreateIntegration(context.Background(), IntegrationIn{Name: "test"})
+			}
+
+			var diags diag.Diagnostics
+			addClientError(&diags, "Unable to create integration", err)
+
+			if len(diags) != 1 || diags[0].Summary() != tt.expectedSummary {
+				t.Fatalf("expected error %q, got %v", tt.expectedSummary, diags)
+			}
+		})
+	}
+}
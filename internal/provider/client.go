@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -45,6 +48,25 @@ func (c *EunoClient) releaseRateLimit() {
 	<-c.rateLimiter
 }
 
+// APIError is returned when the API rejects a request
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// Account represents the account the API key is used with
+type Account struct {
+	ID                      int      `json:"id"`
+	Name                    string   `json:"name"`
+	Plan                    string   `json:"plan"`
+	EnabledIntegrationTypes []string `json:"enabled_integration_types"`
+}
+
 // IntegrationSchedule represents the schedule configuration
 type IntegrationSchedule struct {
 	TimeZone     string   `json:"time_zone"`
@@ -85,6 +107,7 @@ type IntegrationOut struct {
 	Schedule                    *IntegrationSchedule   `json:"schedule"`
 	CollectedIntegrationData    map[string]interface{} `json:"collected_integration_data"`
 	LastRunStatus               *string                `json:"last_run_status"`
+	LastRunError                *string                `json:"last_run_error"`
 	LastCompletedRunEndTime     *string                `json:"last_completed_run_end_time"`
 	Health                      *string                `json:"health"`
 	TriggerType                 *string                `json:"trigger_type"`
@@ -95,6 +118,92 @@ type IntegrationOut struct {
 	PendingCredentialsLookupKey *string                `json:"pending_credentials_lookup_key"`
 }
 
+// IntegrationRun represents a single run of an integration
+type IntegrationRun struct {
+	ID             int              `json:"id"`
+	IntegrationID  int              `json:"integration_id"`
+	Status         string           `json:"status"`
+	TriggerType    *string          `json:"trigger_type"`
+	StartTime      *string          `json:"start_time"`
+	EndTime        *string          `json:"end_time"`
+	ErrorMessage   *string          `json:"error_message"`
+	ResourceCounts map[string]int64 `json:"resource_counts"`
+}
+
+// InvalidationRequest represents the input for invalidating the collected data of an integration
+type InvalidationRequest struct {
+	RevisionID *int `json:"revision_id,omitempty"`
+}
+
+// InvalidationResult represents the outcome of an invalidation
+type InvalidationResult struct {
+	InvalidatedResources int  `json:"invalidated_resources"`
+	RevisionID           *int `json:"revision_id"`
+}
+
+// IntegrationRunPage represents a page of runs returned by the runs endpoint
+type IntegrationRunPage struct {
+	Items []IntegrationRun `json:"items"`
+	Total int              `json:"total"`
+}
+
+// IntegrationPage represents a page of integrations returned by the list endpoint
+type IntegrationPage struct {
+	Items []IntegrationOut `json:"items"`
+	Total int              `json:"total"`
+}
+
+// integrationPageSize is the number of integrations requested per page when listing integrations
+const integrationPageSize = 100
+
+// maxIntegrationPages bounds the number of pages requested when listing integrations
+const maxIntegrationPages = 1000
+
+// integrationRunPageSize is the maximum number of runs requested per page when listing runs
+const integrationRunPageSize = 100
+
+// ErrRunInProgress is returned when a run is requested while another run of the integration is in progress
+var ErrRunInProgress = errors.New("integration run already in progress")
+
+// GetAccount retrieves the account of the client, verifying that the API key has access to it
+func (c *EunoClient) GetAccount(ctx context.Context) (*Account, error) {
+	if err := c.acquireRateLimit(ctx); err != nil {
+		return nil, err
+	}
+	defer c.releaseRateLimit()
+
+	url := fmt.Sprintf("%s/accounts/%d", c.serverURL, c.accountID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var result Account
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // CreateIntegration creates a new integration
 func (c *EunoClient) CreateIntegration(ctx context.Context, integration IntegrationIn) (*IntegrationOut, error) {
 	if err := c.acquireRateLimit(ctx); err != nil {
@@ -129,7 +238,7 @@ func (c *EunoClient) CreateIntegration(ctx context.Context, integration Integrat
 	}
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	var result IntegrationOut
@@ -172,7 +281,7 @@ func (c *EunoClient) GetIntegration(ctx context.Context, integrationID int) (*In
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	var result IntegrationOut
@@ -183,6 +292,82 @@ func (c *EunoClient) GetIntegration(ctx context.Context, integrationID int) (*In
 	return &result, nil
 }
 
+// ListIntegrations retrieves all integrations of the account, following pagination.
+// When integrationType is not empty, only integrations of that type are returned.
+func (c *EunoClient) ListIntegrations(ctx context.Context, integrationType string) ([]IntegrationOut, error) {
+	var integrations []IntegrationOut
+	previousFirstID := 0
+
+	for page := 1; page <= maxIntegrationPages; page++ {
+		result, err := c.listIntegrationsPage(ctx, integrationType, page)
+		if err != nil {
+			return nil, err
+		}
+
+		// A server ignoring the page parameter returns the same page again
+		if len(result.Items) > 0 && result.Items[0].ID == previousFirstID {
+			return nil, fmt.Errorf("page %d of the integrations repeats the previous page", page)
+		}
+		if len(result.Items) > 0 {
+			previousFirstID = result.Items[0].ID
+		}
+
+		integrations = append(integrations, result.Items...)
+
+		if len(result.Items) < integrationPageSize || (result.Total > 0 && len(integrations) >= result.Total) {
+			return integrations, nil
+		}
+	}
+
+	return nil, fmt.Errorf("more than %d integrations were listed", maxIntegrationPages*integrationPageSize)
+}
+
+// listIntegrationsPage retrieves a single page of integrations
+func (c *EunoClient) listIntegrationsPage(ctx context.Context, integrationType string, page int) (*IntegrationPage, error) {
+	if err := c.acquireRateLimit(ctx); err != nil {
+		return nil, err
+	}
+	defer c.releaseRateLimit()
+
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("page_size", strconv.Itoa(integrationPageSize))
+	if integrationType != "" {
+		query.Set("integration_type", integrationType)
+	}
+
+	requestURL := fmt.Sprintf("%s/accounts/%d/integrations?%s", c.serverURL, c.accountID, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var result IntegrationPage
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // UpdateIntegration updates an existing integration
 func (c *EunoClient) UpdateIntegration(ctx context.Context, integrationID int, integration IntegrationIn) (*IntegrationOut, error) {
 	if err := c.acquireRateLimit(ctx); err != nil {
@@ -221,7 +406,7 @@ func (c *EunoClient) UpdateIntegration(ctx context.Context, integrationID int, i
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	var result IntegrationOut
@@ -260,8 +445,224 @@ func (c *EunoClient) DeleteIntegration(ctx context.Context, integrationID int) e
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	return nil
 }
+
+// RotateTriggerSecret rotates the trigger secret of a push integration
+func (c *EunoClient) RotateTriggerSecret(ctx context.Context, integrationID int) (*IntegrationOut, error) {
+	if err := c.acquireRateLimit(ctx); err != nil {
+		return nil, err
+	}
+	defer c.releaseRateLimit()
+
+	url := fmt.Sprintf("%s/accounts/%d/integrations/%d/rotate_trigger_secret", c.serverURL, c.accountID, integrationID)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("integration not found")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var result IntegrationOut
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// RunIntegration triggers a run of an integration
+func (c *EunoClient) RunIntegration(ctx context.Context, integrationID int) (*IntegrationRun, error) {
+	if err := c.acquireRateLimit(ctx); err != nil {
+		return nil, err
+	}
+	defer c.releaseRateLimit()
+
+	url := fmt.Sprintf("%s/accounts/%d/integrations/%d/run", c.serverURL, c.accountID, integrationID)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("integration not found")
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		return nil, ErrRunInProgress
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted {
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var result IntegrationRun
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ListIntegrationRuns retrieves the most recent runs of an integration, newest first, following
+// pagination until limit runs are collected. When since is not empty, only runs started at or
+// after that time are returned.
+func (c *EunoClient) ListIntegrationRuns(ctx context.Context, integrationID int, limit int, since string) ([]IntegrationRun, error) {
+	pageSize := min(limit, integrationRunPageSize)
+	runs := make([]IntegrationRun, 0, limit)
+
+	for page := 1; len(runs) < limit; page++ {
+		result, err := c.listIntegrationRunsPage(ctx, integrationID, since, page, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		runs = append(runs, result.Items...)
+
+		if len(result.Items) < pageSize || (result.Total > 0 && page*pageSize >= result.Total) {
+			break
+		}
+	}
+
+	if len(runs) > limit {
+		runs = runs[:limit]
+	}
+
+	return runs, nil
+}
+
+// listIntegrationRunsPage retrieves a single page of runs of an integration
+func (c *EunoClient) listIntegrationRunsPage(ctx context.Context, integrationID int, since string, page, pageSize int) (*IntegrationRunPage, error) {
+	if err := c.acquireRateLimit(ctx); err != nil {
+		return nil, err
+	}
+	defer c.releaseRateLimit()
+
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("page_size", strconv.Itoa(pageSize))
+	if since != "" {
+		query.Set("since", since)
+	}
+
+	requestURL := fmt.Sprintf("%s/accounts/%d/integrations/%d/runs?%s", c.serverURL, c.accountID, integrationID, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("integration not found")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var result IntegrationRunPage
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// InvalidateIntegration invalidates the data collected by an integration
+func (c *EunoClient) InvalidateIntegration(ctx context.Context, integrationID int, invalidation InvalidationRequest) (*InvalidationResult, error) {
+	if err := c.acquireRateLimit(ctx); err != nil {
+		return nil, err
+	}
+	defer c.releaseRateLimit()
+
+	url := fmt.Sprintf("%s/accounts/%d/integrations/%d/invalidate", c.serverURL, c.accountID, integrationID)
+
+	jsonData, err := json.Marshal(invalidation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal invalidation data: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("integration not found")
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var result InvalidationResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
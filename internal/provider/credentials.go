@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// validateCredentials verifies that the API key is valid and has access to the configured
+// account, explaining how to fix the provider configuration when it does not.
+func validateCredentials(ctx context.Context, client *EunoClient, config EunoProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The credentials cannot be verified until their values are known
+	if config.ServerURL.IsUnknown() || config.APIKey.IsUnknown() || config.AccountID.IsUnknown() {
+		return diags
+	}
+
+	_, err := client.GetAccount(ctx)
+	if err == nil {
+		return diags
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		diags.AddError(
+			"Unable to Verify Euno Credentials",
+			fmt.Sprintf("Unable to reach the Euno API at %s to verify the credentials: %s. Check server_url, or set validate_credentials to false to skip the verification.", config.ServerURL.ValueString(), err),
+		)
+		return diags
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		diags.AddAttributeError(
+			path.Root("api_key"),
+			"Invalid Euno API Key",
+			fmt.Sprintf("The API key was rejected by %s. Check that the key is correct and has not expired or been revoked.", config.ServerURL.ValueString()),
+		)
+	case http.StatusForbidden:
+		diags.AddAttributeError(
+			path.Root("account_id"),
+			"Euno Account Access Denied",
+			fmt.Sprintf("The API key does not have access to account %d. Check account_id, or use an API key of that account.", config.AccountID.ValueInt64()),
+		)
+	case http.StatusNotFound:
+		diags.AddAttributeError(
+			path.Root("account_id"),
+			"Euno Account Not Found",
+			fmt.Sprintf("Account %d was not found at %s. Check account_id and server_url.", config.AccountID.ValueInt64(), config.ServerURL.ValueString()),
+		)
+	default:
+		diags.AddError(
+			"Unable to Verify Euno Credentials",
+			fmt.Sprintf("The Euno API failed to verify the credentials: %s. Set validate_credentials to false to skip the verification.", apiErr),
+		)
+	}
+
+	return diags
+}
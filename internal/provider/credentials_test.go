@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateCredentials(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		expectedError string
+		expectedPath  path.Path
+	}{
+		{name: "valid", status: http.StatusOK},
+		{name: "invalid key", status: http.StatusUnauthorized, expectedError: "Invalid Euno API Key", expectedPath: path.Root("api_key")},
+		{name: "no access", status: http.StatusForbidden, expectedError: "Euno Account Access Denied", expectedPath: path.Root("account_id")},
+		{name: "unknown account", status: http.StatusNotFound, expectedError: "Euno Account Not Found", expectedPath: path.Root("account_id")},
+		{name: "server error", status: http.StatusInternalServerError, expectedError: "Unable to Verify Euno Credentials"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/accounts/5" || r.Header.Get("Authorization") != "Bearer key" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				_ = json.NewEncoder(w).Encode(Account{ID: 5, Name: "analytics", EnabledIntegrationTypes: []string{"snowflake"}})
+			}))
+			defer server.Close()
+
+			config := EunoProviderModel{
+				ServerURL: types.StringValue(server.URL),
+				APIKey:    types.StringValue("key"),
+				AccountID: types.Int64Value(5),
+			}
+			diags := validateCredentials(context.Background(), NewEunoClient(server.URL, "key", 5), config)
+
+			if tt.expectedError == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != tt.expectedError {
+				t.Fatalf("expected error %q, got %v", tt.expectedError, diags)
+			}
+			if tt.expectedPath.String() != "" {
+				withPath, ok := diags.Errors()[0].(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(tt.expectedPath) {
+					t.Errorf("expected error on %s, got %v", tt.expectedPath, diags.Errors()[0])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCredentialsUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	config := EunoProviderModel{
+		ServerURL: types.StringValue(server.URL),
+		APIKey:    types.StringValue("key"),
+		AccountID: types.Int64Value(5),
+	}
+	diags := validateCredentials(context.Background(), NewEunoClient(server.URL, "key", 5), config)
+	if diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != "Unable to Verify Euno Credentials" {
+		t.Fatalf("expected unreachable error, got %v", diags)
+	}
+
+	config.APIKey = types.StringUnknown()
+	if diags := validateCredentials(context.Background(), NewEunoClient(server.URL, "", 5), config); diags.HasError() {
+		t.Errorf("expected unknown credentials to be skipped, got %v", diags)
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scheduleAttributeTypes are the attribute types of the schedule object returned by daily_schedule
+var scheduleAttributeTypes = map[string]attr.Type{
+	"time_zone":     types.StringType,
+	"repeat_on":     types.ListType{ElemType: types.StringType},
+	"repeat_time":   types.StringType,
+	"repeat_period": types.Int64Type,
+}
+
+// Ensure DailyScheduleFunction satisfies the function interface.
+var _ function.Function = &DailyScheduleFunction{}
+
+// DailyScheduleFunction defines the daily_schedule function implementation.
+type DailyScheduleFunction struct{}
+
+// NewDailyScheduleFunction is a helper function to simplify the provider server and testing implementation.
+func NewDailyScheduleFunction() function.Function {
+	return &DailyScheduleFunction{}
+}
+
+// Metadata returns the function name.
+func (f *DailyScheduleFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "daily_schedule"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *DailyScheduleFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a daily schedule",
+		MarkdownDescription: "Builds a validated schedule that runs once a day at a given time, optionally only on some days of the week",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "time_zone",
+				MarkdownDescription: "The IANA time zone of the schedule, such as `Europe/Berlin`",
+			},
+			function.StringParameter{
+				Name:                "repeat_time",
+				MarkdownDescription: "The time of day to run at, in HH:MM or HH:MM:SS format",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "repeat_on",
+			MarkdownDescription: "The days of the week to run on, such as `monday` or `mon`. Defaults to every day",
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: scheduleAttributeTypes,
+		},
+	}
+}
+
+// Run builds the schedule.
+func (f *DailyScheduleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timeZone, repeatTime string
+	var repeatOn []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timeZone, &repeatTime, &repeatOn))
+
+	if resp.Error != nil {
+		return
+	}
+
+	normalizedTime, err := normalizeRepeatTime(repeatTime)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	schedule := IntegrationSchedule{TimeZone: timeZone, RepeatTime: normalizedTime}
+	if len(repeatOn) > 0 {
+		schedule.RepeatOn, err = normalizeScheduleDays(repeatOn)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(2, err.Error())
+			return
+		}
+	}
+
+	if _, err := parseSchedule(schedule); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	// The schedule runs every day when repeat_on is null
+	days := types.ListNull(types.StringType)
+	if schedule.RepeatOn != nil {
+		elements := make([]attr.Value, len(schedule.RepeatOn))
+		for i, day := range schedule.RepeatOn {
+			elements[i] = types.StringValue(day)
+		}
+		days = types.ListValueMust(types.StringType, elements)
+	}
+
+	result := types.ObjectValueMust(scheduleAttributeTypes, map[string]attr.Value{
+		"time_zone":     types.StringValue(schedule.TimeZone),
+		"repeat_on":     days,
+		"repeat_time":   types.StringValue(schedule.RepeatTime),
+		"repeat_period": types.Int64Null(),
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
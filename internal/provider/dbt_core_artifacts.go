@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/euno-ai/terraform-provider-euno/dbtpush"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure DbtCoreArtifactsResource satisfies various resource interfaces.
+var _ resource.Resource = &DbtCoreArtifactsResource{}
+var _ resource.ResourceWithValidateConfig = &DbtCoreArtifactsResource{}
+var _ resource.ResourceWithModifyPlan = &DbtCoreArtifactsResource{}
+
+// defaultUploadTimeout is the default time allowed to upload dbt artifacts
+const defaultUploadTimeout = 10 * time.Minute
+
+// DbtCoreArtifactsResourceModel describes the dbt Core artifacts resource data model.
+type DbtCoreArtifactsResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	TriggerURL     types.String   `tfsdk:"trigger_url"`
+	TriggerSecret  types.String   `tfsdk:"trigger_secret"`
+	TargetDir      types.String   `tfsdk:"target_dir"`
+	Files          types.List     `tfsdk:"files"`
+	ArtifactHashes types.Map      `tfsdk:"artifact_hashes"`
+	RunID          types.String   `tfsdk:"run_id"`
+	UploadedAt     types.String   `tfsdk:"uploaded_at"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// DbtCoreArtifactsResource defines the dbt Core artifacts resource implementation.
+// Artifacts are uploaded to the trigger URL of a dbt Core integration whenever their hashes change.
+type DbtCoreArtifactsResource struct{}
+
+// NewDbtCoreArtifactsResource is a helper function to simplify the provider server and testing implementation.
+func NewDbtCoreArtifactsResource() resource.Resource {
+	return &DbtCoreArtifactsResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *DbtCoreArtifactsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbt_core_artifacts"
+}
+
+// Schema defines the schema for the resource.
+func (r *DbtCoreArtifactsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Uploads dbt Core artifacts to the trigger URL of a euno_dbt_core_integration whenever their contents change",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The run ID of the last upload, or the upload time when Euno does not report a run ID",
+			},
+			"trigger_url": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The trigger URL of the dbt Core integration, e.g. euno_dbt_core_integration.main.trigger_url",
+			},
+			"trigger_secret": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "The trigger secret of the dbt Core integration, e.g. euno_dbt_core_integration.main.trigger_secret or ephemeral.euno_integration_trigger_credentials.dbt.trigger_secret. Write-only, so it is never stored in the plan or state",
+			},
+			"target_dir": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The dbt target directory. manifest.json is uploaded together with catalog.json and run_results.json when present. Conflicts with files",
+			},
+			"files": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Paths of the artifacts to upload. File names must be unique. Conflicts with target_dir",
+			},
+			"artifact_hashes": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 hash of each uploaded artifact, by file name. A change in any hash uploads the artifacts again",
+			},
+			"run_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the run triggered by the last upload, when reported by Euno",
+			},
+			"uploaded_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp of the last upload",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig validates that exactly one artifact source is configured.
+func (r *DbtCoreArtifactsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DbtCoreArtifactsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.TargetDir.IsUnknown() || data.Files.IsUnknown() {
+		return
+	}
+
+	if data.TargetDir.IsNull() == data.Files.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_dir"),
+			"Invalid Artifacts Configuration",
+			"Exactly one of target_dir or files must be set",
+		)
+	}
+}
+
+// ModifyPlan hashes the artifacts, planning an upload when any hash changed.
+func (r *DbtCoreArtifactsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to upload when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DbtCoreArtifactsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The artifacts cannot be hashed until their location is known
+	if plan.TargetDir.IsUnknown() || plan.Files.IsUnknown() || hasUnknownElement(plan.Files) {
+		return
+	}
+
+	artifacts, err := readDbtArtifacts(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read dbt Artifacts", err.Error())
+		return
+	}
+
+	hashes, diags := types.MapValueFrom(ctx, types.StringType, dbtpush.Hash(artifacts))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("artifact_hashes"), hashes)...)
+
+	// Keep the last upload when neither the artifacts nor the destination changed
+	var state *DbtCoreArtifactsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if state != nil && state.ArtifactHashes.Equal(hashes) && state.TriggerURL.Equal(plan.TriggerURL) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), state.ID)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("run_id"), state.RunID)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("uploaded_at"), state.UploadedAt)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("run_id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("uploaded_at"), types.StringUnknown())...)
+}
+
+// Create uploads the artifacts and sets the initial Terraform state.
+func (r *DbtCoreArtifactsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DbtCoreArtifactsResourceModel
+
+	// Read Terraform plan data into the model, and the write-only secret from the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trigger_secret"), &data.TriggerSecret)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultUploadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.upload(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values are never saved
+	data.TriggerSecret = types.StringNull()
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state. Uploads cannot be read back, so there is nothing to refresh
+// apart from dropping a trigger secret stored before it became write-only.
+func (r *DbtCoreArtifactsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("trigger_secret"), types.StringNull())...)
+}
+
+// Update uploads the artifacts again when they changed since the last upload.
+func (r *DbtCoreArtifactsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DbtCoreArtifactsResourceModel
+
+	// Read Terraform plan data into the model, and the write-only secret from the configuration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trigger_secret"), &data.TriggerSecret)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUploadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only upload when the artifacts or the destination changed, not for timeout changes
+	if data.UploadedAt.IsUnknown() {
+		resp.Diagnostics.Append(r.upload(ctx, &data)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Write-only values are never saved
+	data.TriggerSecret = types.StringNull()
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from the Terraform state. Uploaded artifacts are kept in Euno.
+func (r *DbtCoreArtifactsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// upload reads the artifacts, uploads them and records the result in the model
+func (r *DbtCoreArtifactsResource) upload(ctx context.Context, data *DbtCoreArtifactsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	artifacts, err := readDbtArtifacts(*data)
+	if err != nil {
+		diags.AddError("Unable to Read dbt Artifacts", err.Error())
+		return diags
+	}
+
+	hashes, hashDiags := types.MapValueFrom(ctx, types.StringType, dbtpush.Hash(artifacts))
+	diags.Append(hashDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// Uploading different contents than planned would make the plan misleading
+	if !data.ArtifactHashes.IsUnknown() && !data.ArtifactHashes.Equal(hashes) {
+		diags.AddError(
+			"dbt Artifacts Changed",
+			"The dbt artifacts changed between plan and apply. Run terraform plan again to upload the current artifacts.",
+		)
+		return diags
+	}
+
+	result, err := dbtpush.NewClient(data.TriggerURL.ValueString(), data.TriggerSecret.ValueString()).Upload(ctx, artifacts)
+	if err != nil {
+		addClientError(&diags, "Unable to upload dbt artifacts", err)
+		return diags
+	}
+
+	uploadedAt := time.Now().UTC().Format(time.RFC3339)
+
+	data.ArtifactHashes = hashes
+	data.UploadedAt = types.StringValue(uploadedAt)
+	if result.RunID != "" {
+		data.RunID = types.StringValue(result.RunID)
+		data.ID = types.StringValue(result.RunID)
+	} else {
+		data.RunID = types.StringNull()
+		data.ID = types.StringValue(uploadedAt)
+	}
+
+	return diags
+}
+
+// readDbtArtifacts reads the artifacts from the configured target directory or files
+func readDbtArtifacts(data DbtCoreArtifactsResourceModel) ([]dbtpush.Artifact, error) {
+	if !data.TargetDir.IsNull() {
+		return dbtpush.ReadTargetDir(data.TargetDir.ValueString())
+	}
+
+	return dbtpush.ReadFiles(convertStringListToAPI(data.Files)...)
+}
+
+// hasUnknownElement returns whether any element of the list is unknown
+func hasUnknownElement(list types.List) bool {
+	for _, elem := range list.Elements() {
+		if elem.IsUnknown() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// dbtArtifactsTestHarness drives the plan and apply steps of a euno_dbt_core_artifacts resource
+type dbtArtifactsTestHarness struct {
+	t        *testing.T
+	resource *DbtCoreArtifactsResource
+	schema   resource.SchemaResponse
+	config   DbtCoreArtifactsResourceModel
+}
+
+func newDbtArtifactsTestHarness(t *testing.T, triggerURL, targetDir string) *dbtArtifactsTestHarness {
+	ctx := context.Background()
+
+	h := &dbtArtifactsTestHarness{t: t, resource: NewDbtCoreArtifactsResource().(*DbtCoreArtifactsResource)}
+	h.resource.Schema(ctx, resource.SchemaRequest{}, &h.schema)
+
+	timeoutsType, diags := h.schema.Schema.TypeAtPath(ctx, path.Root("timeouts"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	h.config = DbtCoreArtifactsResourceModel{
+		ID:             types.StringUnknown(),
+		TriggerURL:     types.StringValue(triggerURL),
+		TriggerSecret:  types.StringValue("secret"),
+		TargetDir:      types.StringValue(targetDir),
+		Files:          types.ListNull(types.StringType),
+		ArtifactHashes: types.MapUnknown(types.StringType),
+		RunID:          types.StringUnknown(),
+		UploadedAt:     types.StringUnknown(),
+		Timeouts:       timeouts.Value{Object: types.ObjectNull(timeoutsType.(timeouts.Type).AttrTypes)},
+	}
+
+	return h
+}
+
+// plan runs ModifyPlan against the prior state, which is nil on create
+func (h *dbtArtifactsTestHarness) plan(state *tfsdk.State) tfsdk.Plan {
+	h.t.Helper()
+	ctx := context.Background()
+
+	// Terraform proposes unknown computed values and never plans write-only values
+	proposed := h.config
+	proposed.TriggerSecret = types.StringNull()
+
+	req := resource.ModifyPlanRequest{
+		Config: h.tfsdkConfig(),
+		Plan:   tfsdk.Plan{Schema: h.schema.Schema},
+		State:  h.nullState(),
+	}
+	h.check(req.Plan.Set(ctx, &proposed))
+	if state != nil {
+		req.State = *state
+	}
+
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	h.resource.ModifyPlan(ctx, req, &resp)
+	h.check(resp.Diagnostics)
+
+	return resp.Plan
+}
+
+// apply runs Create, or Update when there is a prior state
+func (h *dbtArtifactsTestHarness) apply(plan tfsdk.Plan, state *tfsdk.State) (tfsdk.State, bool) {
+	h.t.Helper()
+	ctx := context.Background()
+
+	newState := h.nullState()
+	if state == nil {
+		resp := resource.CreateResponse{State: newState}
+		h.resource.Create(ctx, resource.CreateRequest{Config: h.tfsdkConfig(), Plan: plan}, &resp)
+		return resp.State, resp.Diagnostics.HasError()
+	}
+
+	resp := resource.UpdateResponse{State: newState}
+	h.resource.Update(ctx, resource.UpdateRequest{Config: h.tfsdkConfig(), Plan: plan, State: *state}, &resp)
+	return resp.State, resp.Diagnostics.HasError()
+}
+
+func (h *dbtArtifactsTestHarness) tfsdkConfig() tfsdk.Config {
+	// Build the raw configuration through a state, which can be set from the model
+	state := h.nullState()
+	h.check(state.Set(context.Background(), &h.config))
+	return tfsdk.Config{Schema: h.schema.Schema, Raw: state.Raw}
+}
+
+func (h *dbtArtifactsTestHarness) nullState() tfsdk.State {
+	return tfsdk.State{Schema: h.schema.Schema, Raw: tftypes.NewValue(h.schema.Schema.Type().TerraformType(context.Background()), nil)}
+}
+
+func (h *dbtArtifactsTestHarness) check(diags interface{ HasError() bool }) {
+	h.t.Helper()
+	if diags.HasError() {
+		h.t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestDbtCoreArtifactsUpload(t *testing.T) {
+	ctx := context.Background()
+
+	uploads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uploads++
+		if auth := r.Header.Get("Authorization"); auth != "Bearer secret" {
+			t.Errorf("expected the configured trigger secret, got %q", auth)
+		}
+		_, _ = w.Write([]byte(`{"run_id": "run-1"}`))
+	}))
+	defer server.Close()
+
+	targetDir := t.TempDir()
+	manifest := filepath.Join(targetDir, "manifest.json")
+	if err := os.WriteFile(manifest, []byte(`{"nodes": {}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	h := newDbtArtifactsTestHarness(t, server.URL, targetDir)
+
+	// Create uploads the artifacts
+	state, failed := h.apply(h.plan(nil), nil)
+	if failed || uploads != 1 {
+		t.Fatalf("expected one upload on create, got %d (failed: %t)", uploads, failed)
+	}
+
+	var data DbtCoreArtifactsResourceModel
+	h.check(state.Get(ctx, &data))
+	if !data.RunID.Equal(types.StringValue("run-1")) || !data.ID.Equal(types.StringValue("run-1")) {
+		t.Errorf("expected run ID run-1, got %s", data.RunID)
+	}
+	if !data.TriggerSecret.IsNull() {
+		t.Error("expected the write-only trigger secret not to be stored")
+	}
+
+	// Unchanged artifacts keep the last upload
+	plan := h.plan(&state)
+
+	var planned DbtCoreArtifactsResourceModel
+	h.check(plan.Get(ctx, &planned))
+	if !planned.UploadedAt.Equal(data.UploadedAt) {
+		t.Errorf("expected uploaded_at %s to be kept, got %s", data.UploadedAt, planned.UploadedAt)
+	}
+
+	if _, failed := h.apply(plan, &state); failed || uploads != 1 {
+		t.Fatalf("expected no upload for unchanged artifacts, got %d (failed: %t)", uploads, failed)
+	}
+
+	// Artifacts changed after the plan fail the apply instead of uploading unplanned contents
+	if err := os.WriteFile(manifest, []byte(`{"nodes": {"model.a": {}}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	plan = h.plan(&state)
+	if err := os.WriteFile(manifest, []byte(`{"nodes": {"model.b": {}}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, failed := h.apply(plan, &state); !failed || uploads != 1 {
+		t.Fatalf("expected the apply to fail without uploading, got %d upload(s) (failed: %t)", uploads, failed)
+	}
+}
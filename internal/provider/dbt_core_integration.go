@@ -2,9 +2,10 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,6 +14,7 @@ import (
 // Ensure DbtCoreIntegrationResource satisfies various resource interfaces.
 var _ resource.Resource = &DbtCoreIntegrationResource{}
 var _ resource.ResourceWithImportState = &DbtCoreIntegrationResource{}
+var _ resource.ResourceWithModifyPlan = &DbtCoreIntegrationResource{}
 
 // DbtCoreIntegrationResourceModel describes the DBT Core integration resource data model.
 type DbtCoreIntegrationResourceModel struct {
@@ -21,11 +23,15 @@ type DbtCoreIntegrationResourceModel struct {
 	Active                      types.Bool                 `tfsdk:"active"`
 	TriggerSecret               types.String               `tfsdk:"trigger_secret"`
 	TriggerURL                  types.String               `tfsdk:"trigger_url"`
+	TriggerSecretRotation       types.Map                  `tfsdk:"trigger_secret_rotation"`
+	StoreTriggerSecret          types.Bool                 `tfsdk:"store_trigger_secret"`
 	InvalidationStrategy        *InvalidationStrategyModel `tfsdk:"invalidation_strategy"`
 	PendingCredentialsLookupKey types.String               `tfsdk:"pending_credentials_lookup_key"`
 	LastUpdatedAt               types.String               `tfsdk:"last_updated_at"`
 	CreatedAt                   types.String               `tfsdk:"created_at"`
 	Configuration               DbtCoreConfigurationModel  `tfsdk:"configuration"`
+	Timeouts                    timeouts.Value             `tfsdk:"timeouts"`
+	IntegrationStatusModel
 }
 
 // DbtCoreConfigurationModel describes the DBT Core-specific configuration
@@ -48,7 +54,9 @@ type DbtCoreIntegrationResource struct {
 
 // NewDbtCoreIntegrationResource is a helper function to simplify the provider server and testing implementation.
 func NewDbtCoreIntegrationResource() resource.Resource {
-	return &DbtCoreIntegrationResource{}
+	return &DbtCoreIntegrationResource{
+		BaseIntegrationResource: BaseIntegrationResource{integrationType: "dbt_core"},
+	}
 }
 
 // Metadata returns the resource type name.
@@ -60,11 +68,20 @@ func (r *DbtCoreIntegrationResource) Metadata(ctx context.Context, req resource.
 func (r *DbtCoreIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	// Get common attributes (which now include trigger_secret and trigger_url)
 	attrs := getCommonAttributes()
+	attrs["trigger_secret_rotation"] = schema.MapAttribute{
+		ElementType:         types.StringType,
+		Optional:            true,
+		MarkdownDescription: "Arbitrary map of values that, when changed, rotates the trigger secret in place. The new `trigger_secret` and `trigger_url` are refreshed without recreating the integration",
+	}
+	attrs["store_trigger_secret"] = schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Whether to store `trigger_secret` in state (defaults to true). When false, `trigger_secret` is null and the secret can be read with the `euno_integration_trigger_credentials` ephemeral resource",
+	}
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Euno DBT Core Integration resource (push integration)",
 		Attributes:          attrs,
-		Blocks:              getCommonBlocksForPush(),
+		Blocks:              getCommonBlocksForPush(ctx),
 	}
 
 	// Add DBT Core-specific configuration block
@@ -115,6 +132,28 @@ func (r *DbtCoreIntegrationResource) Schema(ctx context.Context, req resource.Sc
 	}
 }
 
+// ModifyPlan plans a new trigger URL when the trigger secret is rotated. Otherwise the URL
+// is kept from state, so that resources uploading to it are not planned to change.
+func (r *DbtCoreIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate when the resource is being created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state types.Map
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("trigger_secret_rotation"), &plan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("trigger_secret_rotation"), &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Equal(state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("trigger_url"), types.StringUnknown())...)
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *DbtCoreIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data DbtCoreIntegrationResourceModel
@@ -126,6 +165,16 @@ func (r *DbtCoreIntegrationResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Initialize trigger attributes for push integration
 	data.TriggerSecret = types.StringNull()
 	data.TriggerURL = types.StringNull()
@@ -170,7 +219,7 @@ func (r *DbtCoreIntegrationResource) Create(ctx context.Context, req resource.Cr
 	// Convert Terraform data to API format
 	// Note: For push integrations, we don't include schedule
 	integration := IntegrationIn{
-		IntegrationType:      "dbt_core",
+		IntegrationType:      r.integrationType,
 		Name:                 data.Name.ValueString(),
 		Active:               data.Active.ValueBool(),
 		Configuration:        configMap,
@@ -180,7 +229,7 @@ func (r *DbtCoreIntegrationResource) Create(ctx context.Context, req resource.Cr
 	// Create the integration
 	result, err := r.client.CreateIntegration(ctx, integration)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DBT Core integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create DBT Core integration", err)
 		return
 	}
 
@@ -192,14 +241,11 @@ func (r *DbtCoreIntegrationResource) Create(ctx context.Context, req resource.Cr
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
 	// Convert trigger back to Terraform format for push integrations
 	// Convert trigger values back to Terraform attributes for push integrations
-	if result.TriggerSecret != nil {
-		data.TriggerSecret = types.StringValue(*result.TriggerSecret)
-	} else {
-		data.TriggerSecret = types.StringNull()
-	}
+	data.TriggerSecret = dbtCoreTriggerSecretFromAPI(data.StoreTriggerSecret, result.TriggerSecret)
 	if result.TriggerURL != nil {
 		data.TriggerURL = types.StringValue(*result.TriggerURL)
 	} else {
@@ -265,10 +311,20 @@ func (r *DbtCoreIntegrationResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the integration from the API
 	result, err := r.client.GetIntegration(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DBT Core integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read DBT Core integration", err)
 		return
 	}
 
@@ -280,14 +336,11 @@ func (r *DbtCoreIntegrationResource) Read(ctx context.Context, req resource.Read
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
 	// Convert trigger back to Terraform format for push integrations
 	// Convert trigger values back to Terraform attributes for push integrations
-	if result.TriggerSecret != nil {
-		data.TriggerSecret = types.StringValue(*result.TriggerSecret)
-	} else {
-		data.TriggerSecret = types.StringNull()
-	}
+	data.TriggerSecret = dbtCoreTriggerSecretFromAPI(data.StoreTriggerSecret, result.TriggerSecret)
 	if result.TriggerURL != nil {
 		data.TriggerURL = types.StringValue(*result.TriggerURL)
 	} else {
@@ -315,15 +368,26 @@ func (r *DbtCoreIntegrationResource) Read(ctx context.Context, req resource.Read
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *DbtCoreIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data DbtCoreIntegrationResourceModel
+	var data, state DbtCoreIntegrationResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Convert configuration to API format (same as Create)
 	configMap := map[string]interface{}{
 		"build_target": data.Configuration.BuildTarget.ValueString(),
@@ -344,7 +408,7 @@ func (r *DbtCoreIntegrationResource) Update(ctx context.Context, req resource.Up
 	// Convert Terraform data to API format
 	// Note: For push integrations, we don't include schedule
 	integration := IntegrationIn{
-		IntegrationType:      "dbt_core",
+		IntegrationType:      r.integrationType,
 		Name:                 data.Name.ValueString(),
 		Active:               data.Active.ValueBool(),
 		Configuration:        configMap,
@@ -354,10 +418,23 @@ func (r *DbtCoreIntegrationResource) Update(ctx context.Context, req resource.Up
 	// Update the integration
 	result, err := r.client.UpdateIntegration(ctx, int(data.ID.ValueInt64()), integration)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DBT Core integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update DBT Core integration", err)
 		return
 	}
 
+	// Rotate the trigger secret when the rotation keepers changed. The update already succeeded,
+	// so a failed rotation still saves the state, keeping the previous keepers to retry it.
+	var rotateErr error
+	if !data.TriggerSecretRotation.Equal(state.TriggerSecretRotation) {
+		rotated, err := r.client.RotateTriggerSecret(ctx, int(data.ID.ValueInt64()))
+		if err != nil {
+			rotateErr = err
+			data.TriggerSecretRotation = state.TriggerSecretRotation
+		} else {
+			result = rotated
+		}
+	}
+
 	// Update the model with the response data (same as Create)
 	data.ID = types.Int64Value(int64(result.ID))
 	data.Name = types.StringValue(result.Name)
@@ -366,14 +443,11 @@ func (r *DbtCoreIntegrationResource) Update(ctx context.Context, req resource.Up
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
 	// Convert trigger back to Terraform format for push integrations
 	// Convert trigger values back to Terraform attributes for push integrations
-	if result.TriggerSecret != nil {
-		data.TriggerSecret = types.StringValue(*result.TriggerSecret)
-	} else {
-		data.TriggerSecret = types.StringNull()
-	}
+	data.TriggerSecret = dbtCoreTriggerSecretFromAPI(data.StoreTriggerSecret, result.TriggerSecret)
 	if result.TriggerURL != nil {
 		data.TriggerURL = types.StringValue(*result.TriggerURL)
 	} else {
@@ -397,6 +471,10 @@ func (r *DbtCoreIntegrationResource) Update(ctx context.Context, req resource.Up
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if rotateErr != nil {
+		addClientError(&resp.Diagnostics, "Unable to rotate DBT Core integration trigger secret", rotateErr)
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -410,10 +488,20 @@ func (r *DbtCoreIntegrationResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the integration
 	err := r.client.DeleteIntegration(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DBT Core integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete DBT Core integration", err)
 		return
 	}
 }
@@ -422,3 +510,13 @@ func (r *DbtCoreIntegrationResource) Delete(ctx context.Context, req resource.De
 func (r *DbtCoreIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	r.BaseIntegrationResource.ImportState(ctx, req, resp)
 }
+
+// dbtCoreTriggerSecretFromAPI returns the trigger secret to store in state, which is null when
+// store_trigger_secret is false
+func dbtCoreTriggerSecretFromAPI(store types.Bool, secret *string) types.String {
+	if secret == nil || (!store.IsNull() && !store.ValueBool()) {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*secret)
+}
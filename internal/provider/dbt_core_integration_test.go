@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDbtCoreIntegrationModifyPlan(t *testing.T) {
+	ctx := context.Background()
+
+	r := NewDbtCoreIntegrationResource().(*DbtCoreIntegrationResource)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	rotation := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue(value)})
+	}
+
+	tests := []struct {
+		name            string
+		planRotation    types.Map
+		expectedURLKept bool
+	}{
+		{name: "unchanged keepers", planRotation: rotation("1"), expectedURLKept: true},
+		{name: "changed keepers", planRotation: rotation("2")},
+		{name: "removed keepers", planRotation: types.MapNull(types.StringType)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			diags := state.SetAttribute(ctx, path.Root("trigger_url"), types.StringValue("https://euno.example/trigger"))
+			diags.Append(state.SetAttribute(ctx, path.Root("trigger_secret_rotation"), rotation("1"))...)
+
+			// The plan keeps the trigger URL from state, as UseStateForUnknown does
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw.Copy()}
+			diags.Append(plan.SetAttribute(ctx, path.Root("trigger_secret_rotation"), tt.planRotation)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var triggerURL types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("trigger_url"), &triggerURL)...)
+
+			if tt.expectedURLKept && !triggerURL.Equal(types.StringValue("https://euno.example/trigger")) {
+				t.Errorf("expected the trigger URL to be kept, got %s", triggerURL)
+			}
+			if !tt.expectedURLKept && !triggerURL.IsUnknown() {
+				t.Errorf("expected an unknown trigger URL after rotation, got %s", triggerURL)
+			}
+		})
+	}
+}
+
+// newDbtCoreTestServer serves updates and trigger secret rotations of integration 7
+func newDbtCoreTestServer(t *testing.T, rotateStatus int, rotations *int) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secret, url := "old-secret", "https://euno.example/trigger/old"
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/1/integrations/7/rotate_trigger_secret":
+			*rotations++
+			if rotateStatus != http.StatusOK {
+				w.WriteHeader(rotateStatus)
+				return
+			}
+			secret, url = "new-secret", "https://euno.example/trigger/new"
+		case r.Method == http.MethodPatch && r.URL.Path == "/accounts/1/integrations/7":
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		active := true
+		_ = json.NewEncoder(w).Encode(IntegrationOut{
+			ID:              7,
+			Name:            "dbt",
+			IntegrationType: "dbt_core",
+			Active:          &active,
+			TriggerSecret:   &secret,
+			TriggerURL:      &url,
+			Configuration:   map[string]interface{}{"build_target": "prod"},
+		})
+	}))
+}
+
+func TestRotateTriggerSecret(t *testing.T) {
+	rotations := 0
+	server := newDbtCoreTestServer(t, http.StatusOK, &rotations)
+	defer server.Close()
+
+	result, err := NewEunoClient(server.URL, "key", 1).RotateTriggerSecret(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if rotations != 1 || result.TriggerSecret == nil || *result.TriggerSecret != "new-secret" {
+		t.Errorf("expected the rotated secret, got %v after %d rotation(s)", result.TriggerSecret, rotations)
+	}
+}
+
+func TestDbtCoreIntegrationUpdateRotation(t *testing.T) {
+	ctx := context.Background()
+
+	rotation := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue(value)})
+	}
+
+	tests := []struct {
+		name              string
+		planRotation      types.Map
+		rotateStatus      int
+		expectedRotations int
+		expectedError     bool
+		expectedSecret    string
+		expectedKeepers   types.Map
+	}{
+		{name: "unchanged keepers", planRotation: rotation("1"), rotateStatus: http.StatusOK, expectedSecret: "old-secret", expectedKeepers: rotation("1")},
+		{name: "changed keepers", planRotation: rotation("2"), rotateStatus: http.StatusOK, expectedRotations: 1, expectedSecret: "new-secret", expectedKeepers: rotation("2")},
+		{name: "failed rotation", planRotation: rotation("2"), rotateStatus: http.StatusInternalServerError, expectedRotations: 1, expectedError: true, expectedSecret: "old-secret", expectedKeepers: rotation("1")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rotations := 0
+			server := newDbtCoreTestServer(t, tt.rotateStatus, &rotations)
+			defer server.Close()
+
+			r := NewDbtCoreIntegrationResource().(*DbtCoreIntegrationResource)
+			r.client = NewEunoClient(server.URL, "key", 1)
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			diags := state.SetAttribute(ctx, path.Root("id"), types.Int64Value(7))
+			diags.Append(state.SetAttribute(ctx, path.Root("name"), types.StringValue("dbt"))...)
+			diags.Append(state.SetAttribute(ctx, path.Root("configuration").AtName("build_target"), types.StringValue("prod"))...)
+			diags.Append(state.SetAttribute(ctx, path.Root("trigger_secret"), types.StringValue("old-secret"))...)
+			diags.Append(state.SetAttribute(ctx, path.Root("trigger_secret_rotation"), rotation("1"))...)
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw.Copy()}
+			diags.Append(plan.SetAttribute(ctx, path.Root("trigger_secret_rotation"), tt.planRotation)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			resp := resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &resp)
+
+			if resp.Diagnostics.HasError() != tt.expectedError {
+				t.Fatalf("expected error %t, got %v", tt.expectedError, resp.Diagnostics)
+			}
+			if rotations != tt.expectedRotations {
+				t.Errorf("expected %d rotation(s), got %d", tt.expectedRotations, rotations)
+			}
+
+			// The state is saved even when the rotation fails, so the successful update is not lost
+			var data DbtCoreIntegrationResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+			if !data.TriggerSecret.Equal(types.StringValue(tt.expectedSecret)) {
+				t.Errorf("expected trigger secret %q, got %s", tt.expectedSecret, data.TriggerSecret)
+			}
+			if !data.TriggerSecretRotation.Equal(tt.expectedKeepers) {
+				t.Errorf("expected rotation keepers %s, got %s", tt.expectedKeepers, data.TriggerSecretRotation)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -12,6 +13,7 @@ import (
 // Ensure FivetranIntegrationResource satisfies various resource interfaces.
 var _ resource.Resource = &FivetranIntegrationResource{}
 var _ resource.ResourceWithImportState = &FivetranIntegrationResource{}
+var _ resource.ResourceWithValidateConfig = &FivetranIntegrationResource{}
 
 // FivetranIntegrationResourceModel describes the Fivetran integration resource data model.
 type FivetranIntegrationResourceModel struct {
@@ -21,9 +23,18 @@ type FivetranIntegrationResourceModel struct {
 
 // FivetranConfigurationModel describes the Fivetran-specific configuration
 type FivetranConfigurationModel struct {
-	APIKey    types.String `tfsdk:"api_key"`
-	APISecret types.String `tfsdk:"api_secret"`
-	BaseURL   types.String `tfsdk:"base_url"`
+	APIKey             types.String                      `tfsdk:"api_key"`
+	APISecret          types.String                      `tfsdk:"api_secret"`
+	BaseURL            types.String                      `tfsdk:"base_url"`
+	GroupIDs           types.List                        `tfsdk:"group_ids"`
+	ConnectorIDs       types.List                        `tfsdk:"connector_ids"`
+	DestinationMapping []FivetranDestinationMappingModel `tfsdk:"destination_mapping"`
+}
+
+// FivetranDestinationMappingModel maps a Fivetran destination to the Euno integration of its warehouse
+type FivetranDestinationMappingModel struct {
+	DestinationID types.String `tfsdk:"destination_id"`
+	IntegrationID types.Int64  `tfsdk:"integration_id"`
 }
 
 // FivetranIntegrationResource defines the Fivetran integration resource implementation.
@@ -33,7 +44,9 @@ type FivetranIntegrationResource struct {
 
 // NewFivetranIntegrationResource is a helper function to simplify the provider server and testing implementation.
 func NewFivetranIntegrationResource() resource.Resource {
-	return &FivetranIntegrationResource{}
+	return &FivetranIntegrationResource{
+		BaseIntegrationResource: BaseIntegrationResource{integrationType: "fivetran"},
+	}
 }
 
 // Metadata returns the resource type name.
@@ -46,8 +59,8 @@ func (r *FivetranIntegrationResource) Schema(ctx context.Context, req resource.S
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Euno Fivetran Integration resource",
 
-		Attributes: getCommonAttributes(),
-		Blocks:     getCommonBlocks(),
+		Attributes: getCommonAttributesForPull(),
+		Blocks:     getCommonBlocks(ctx),
 	}
 
 	// Add Fivetran-specific configuration block
@@ -69,10 +82,168 @@ func (r *FivetranIntegrationResource) Schema(ctx context.Context, req resource.S
 				Computed:            true,
 				MarkdownDescription: "Fivetran API base URL (defaults to https://api.fivetran.com/v1)",
 			},
+			"group_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Fivetran group IDs to observe. Connectors in all groups visible to the API key are observed when neither group_ids nor connector_ids is set",
+			},
+			"connector_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Fivetran connector IDs to observe, in addition to the connectors in group_ids",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"destination_mapping": schema.ListNestedBlock{
+				MarkdownDescription: "Maps a Fivetran destination to the Euno integration that observes the destination warehouse, so lineage is stitched to its tables",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"destination_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Fivetran destination ID. Fivetran uses the group ID as the ID of its destination",
+						},
+						"integration_id": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "ID of the Euno integration observing the destination warehouse, e.g. euno_snowflake_integration.main.id",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// ValidateConfig validates the connector scoping and destination mapping at plan time.
+func (r *FivetranIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *FivetranConfigurationModel
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("configuration"), &config)...)
+
+	if resp.Diagnostics.HasError() || config == nil {
+		return
+	}
+
+	idLists := []struct {
+		name string
+		ids  types.List
+	}{
+		{"group_ids", config.GroupIDs},
+		{"connector_ids", config.ConnectorIDs},
+	}
+	for _, list := range idLists {
+		for i, elem := range list.ids.Elements() {
+			if id, ok := elem.(types.String); ok && !id.IsUnknown() && id.ValueString() == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("configuration").AtName(list.name).AtListIndex(i),
+					"Invalid Fivetran Configuration",
+					"IDs must not be empty",
+				)
+			}
+		}
+	}
+
+	// Each destination can only be mapped to a single integration
+	destinations := map[string]bool{}
+	for i, mapping := range config.DestinationMapping {
+		if mapping.DestinationID.IsUnknown() || mapping.DestinationID.IsNull() {
+			continue
+		}
+		if destinations[mapping.DestinationID.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("configuration").AtName("destination_mapping").AtListIndex(i).AtName("destination_id"),
+				"Invalid Fivetran Configuration",
+				fmt.Sprintf("Destination %q is mapped more than once", mapping.DestinationID.ValueString()),
+			)
+		}
+		destinations[mapping.DestinationID.ValueString()] = true
+	}
+}
+
+// fivetranConfigurationToAPI converts the Terraform Fivetran configuration to API format
+func fivetranConfigurationToAPI(config FivetranConfigurationModel) map[string]interface{} {
+	configMap := map[string]interface{}{
+		"api_key":    config.APIKey.ValueString(),
+		"api_secret": config.APISecret.ValueString(),
+	}
+
+	if !config.BaseURL.IsNull() && !config.BaseURL.IsUnknown() {
+		configMap["base_url"] = config.BaseURL.ValueString()
+	}
+	if !config.GroupIDs.IsNull() {
+		configMap["group_ids"] = convertStringListToAPI(config.GroupIDs)
+	}
+	if !config.ConnectorIDs.IsNull() {
+		configMap["connector_ids"] = convertStringListToAPI(config.ConnectorIDs)
+	}
+	if len(config.DestinationMapping) > 0 {
+		mappings := make([]map[string]interface{}, 0, len(config.DestinationMapping))
+		for _, mapping := range config.DestinationMapping {
+			mappings = append(mappings, map[string]interface{}{
+				"destination_id": mapping.DestinationID.ValueString(),
+				"integration_id": mapping.IntegrationID.ValueInt64(),
+			})
+		}
+		configMap["destination_mapping"] = mappings
+	}
+
+	return configMap
+}
+
+// fivetranConfigurationFromAPI maps the configuration returned by the API onto the current
+// configuration. Computed values the API does not return are resolved to null.
+func fivetranConfigurationFromAPI(current FivetranConfigurationModel, apiConfig map[string]interface{}) FivetranConfigurationModel {
+	config := current
+
+	if apiKey, ok := apiConfig["api_key"].(string); ok {
+		config.APIKey = types.StringValue(apiKey)
+	}
+	if apiSecret, ok := apiConfig["api_secret"].(string); ok {
+		config.APISecret = types.StringValue(apiSecret)
+	}
+	if baseURL, ok := apiConfig["base_url"].(string); ok {
+		config.BaseURL = types.StringValue(baseURL)
+	} else if config.BaseURL.IsUnknown() {
+		config.BaseURL = types.StringNull()
+	}
+
+	for key, target := range map[string]*types.List{
+		"group_ids":     &config.GroupIDs,
+		"connector_ids": &config.ConnectorIDs,
+	} {
+		v, ok := apiConfig[key]
+		if !ok {
+			continue
+		}
+		// An empty list is equivalent to not setting the attribute
+		if list := convertStringListFromAPI(v); len(list.Elements()) > 0 || !target.IsNull() {
+			*target = list
+		}
+	}
+
+	if v, ok := apiConfig["destination_mapping"]; ok {
+		items, _ := v.([]interface{})
+		mappings := make([]FivetranDestinationMappingModel, 0, len(items))
+		for _, item := range items {
+			mapping, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			destinationID, _ := mapping["destination_id"].(string)
+			integrationID, _ := mapping["integration_id"].(float64)
+			mappings = append(mappings, FivetranDestinationMappingModel{
+				DestinationID: types.StringValue(destinationID),
+				IntegrationID: types.Int64Value(int64(integrationID)),
+			})
+		}
+		// An empty list is equivalent to not setting any destination_mapping block
+		if len(mappings) > 0 || len(current.DestinationMapping) > 0 {
+			config.DestinationMapping = mappings
+		}
+	}
+
+	return config
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *FivetranIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data FivetranIntegrationResourceModel
@@ -84,19 +255,22 @@ func (r *FivetranIntegrationResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
-	// Convert configuration to API format
-	configMap := map[string]interface{}{
-		"api_key":    data.Configuration.APIKey.ValueString(),
-		"api_secret": data.Configuration.APISecret.ValueString(),
-	}
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
 
-	if !data.Configuration.BaseURL.IsNull() {
-		configMap["base_url"] = data.Configuration.BaseURL.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Convert configuration to API format
+	configMap := fivetranConfigurationToAPI(data.Configuration)
+
 	// Convert Terraform data to API format
 	integration := IntegrationIn{
-		IntegrationType:      "fivetran",
+		IntegrationType:      r.integrationType,
 		Name:                 data.Name.ValueString(),
 		Active:               data.Active.ValueBool(),
 		Configuration:        configMap,
@@ -107,7 +281,7 @@ func (r *FivetranIntegrationResource) Create(ctx context.Context, req resource.C
 	// Create the integration
 	result, err := r.client.CreateIntegration(ctx, integration)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Fivetran integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create Fivetran integration", err)
 		return
 	}
 
@@ -119,19 +293,10 @@ func (r *FivetranIntegrationResource) Create(ctx context.Context, req resource.C
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
 	// Convert configuration back to Terraform format
-	if result.Configuration != nil {
-		if apiKey, ok := result.Configuration["api_key"].(string); ok {
-			data.Configuration.APIKey = types.StringValue(apiKey)
-		}
-		if apiSecret, ok := result.Configuration["api_secret"].(string); ok {
-			data.Configuration.APISecret = types.StringValue(apiSecret)
-		}
-		if baseURL, ok := result.Configuration["base_url"].(string); ok {
-			data.Configuration.BaseURL = types.StringValue(baseURL)
-		}
-	}
+	data.Configuration = fivetranConfigurationFromAPI(data.Configuration, result.Configuration)
 
 	// Convert schedule and invalidation strategy back
 	data.Schedule = convertScheduleFromAPI(result.Schedule)
@@ -141,6 +306,11 @@ func (r *FivetranIntegrationResource) Create(ctx context.Context, req resource.C
 		data.PendingCredentialsLookupKey = types.StringValue(*result.PendingCredentialsLookupKey)
 	}
 
+	// Wait for a run to complete when requested
+	if data.WaitForFirstRun.ValueBool() {
+		resp.Diagnostics.Append(r.waitForFirstRun(ctx, &data.BaseIntegrationResourceModel, "Fivetran")...)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -156,10 +326,20 @@ func (r *FivetranIntegrationResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the integration from the API
 	result, err := r.client.GetIntegration(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Fivetran integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read Fivetran integration", err)
 		return
 	}
 
@@ -171,19 +351,10 @@ func (r *FivetranIntegrationResource) Read(ctx context.Context, req resource.Rea
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
 	// Convert configuration back to Terraform format
-	if result.Configuration != nil {
-		if apiKey, ok := result.Configuration["api_key"].(string); ok {
-			data.Configuration.APIKey = types.StringValue(apiKey)
-		}
-		if apiSecret, ok := result.Configuration["api_secret"].(string); ok {
-			data.Configuration.APISecret = types.StringValue(apiSecret)
-		}
-		if baseURL, ok := result.Configuration["base_url"].(string); ok {
-			data.Configuration.BaseURL = types.StringValue(baseURL)
-		}
-	}
+	data.Configuration = fivetranConfigurationFromAPI(data.Configuration, result.Configuration)
 
 	// Convert schedule and invalidation strategy back
 	data.Schedule = convertScheduleFromAPI(result.Schedule)
@@ -208,19 +379,22 @@ func (r *FivetranIntegrationResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	// Convert configuration to API format
-	configMap := map[string]interface{}{
-		"api_key":    data.Configuration.APIKey.ValueString(),
-		"api_secret": data.Configuration.APISecret.ValueString(),
-	}
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
 
-	if !data.Configuration.BaseURL.IsNull() {
-		configMap["base_url"] = data.Configuration.BaseURL.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Convert configuration to API format
+	configMap := fivetranConfigurationToAPI(data.Configuration)
+
 	// Convert Terraform data to API format
 	integration := IntegrationIn{
-		IntegrationType:      "fivetran",
+		IntegrationType:      r.integrationType,
 		Name:                 data.Name.ValueString(),
 		Active:               data.Active.ValueBool(),
 		Configuration:        configMap,
@@ -231,7 +405,7 @@ func (r *FivetranIntegrationResource) Update(ctx context.Context, req resource.U
 	// Update the integration
 	result, err := r.client.UpdateIntegration(ctx, int(data.ID.ValueInt64()), integration)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Fivetran integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update Fivetran integration", err)
 		return
 	}
 
@@ -243,19 +417,10 @@ func (r *FivetranIntegrationResource) Update(ctx context.Context, req resource.U
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
 	// Convert configuration back to Terraform format
-	if result.Configuration != nil {
-		if apiKey, ok := result.Configuration["api_key"].(string); ok {
-			data.Configuration.APIKey = types.StringValue(apiKey)
-		}
-		if apiSecret, ok := result.Configuration["api_secret"].(string); ok {
-			data.Configuration.APISecret = types.StringValue(apiSecret)
-		}
-		if baseURL, ok := result.Configuration["base_url"].(string); ok {
-			data.Configuration.BaseURL = types.StringValue(baseURL)
-		}
-	}
+	data.Configuration = fivetranConfigurationFromAPI(data.Configuration, result.Configuration)
 
 	// Convert schedule and invalidation strategy back
 	data.Schedule = convertScheduleFromAPI(result.Schedule)
@@ -265,6 +430,11 @@ func (r *FivetranIntegrationResource) Update(ctx context.Context, req resource.U
 		data.PendingCredentialsLookupKey = types.StringValue(*result.PendingCredentialsLookupKey)
 	}
 
+	// Wait for a run to complete when requested
+	if data.WaitForFirstRun.ValueBool() {
+		resp.Diagnostics.Append(r.waitForFirstRun(ctx, &data.BaseIntegrationResourceModel, "Fivetran")...)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -280,10 +450,20 @@ func (r *FivetranIntegrationResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the integration
 	err := r.client.DeleteIntegration(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Fivetran integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete Fivetran integration", err)
 		return
 	}
 }
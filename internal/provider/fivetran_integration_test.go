@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFivetranConfigurationRoundTrip(t *testing.T) {
+	config := FivetranConfigurationModel{
+		APIKey:       types.StringValue("key"),
+		APISecret:    types.StringValue("secret"),
+		BaseURL:      types.StringUnknown(),
+		GroupIDs:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("decent_dropsy")}),
+		ConnectorIDs: types.ListNull(types.StringType),
+		DestinationMapping: []FivetranDestinationMappingModel{
+			{DestinationID: types.StringValue("decent_dropsy"), IntegrationID: types.Int64Value(42)},
+			{DestinationID: types.StringValue("lively_bunny"), IntegrationID: types.Int64Value(7)},
+		},
+	}
+
+	// Simulate the JSON round trip through the API
+	body, err := json.Marshal(fivetranConfigurationToAPI(config))
+	if err != nil {
+		t.Fatalf("failed to marshal configuration: %s", err)
+	}
+	var apiConfig map[string]interface{}
+	if err := json.Unmarshal(body, &apiConfig); err != nil {
+		t.Fatalf("failed to unmarshal configuration: %s", err)
+	}
+
+	if _, ok := apiConfig["connector_ids"]; ok {
+		t.Errorf("expected null connector_ids not to be sent, got %v", apiConfig["connector_ids"])
+	}
+
+	result := fivetranConfigurationFromAPI(config, apiConfig)
+
+	if !result.BaseURL.IsNull() {
+		t.Errorf("expected unreturned base_url to be null, got %s", result.BaseURL)
+	}
+	if !result.GroupIDs.Equal(config.GroupIDs) {
+		t.Errorf("expected group_ids %s, got %s", config.GroupIDs, result.GroupIDs)
+	}
+	if !result.ConnectorIDs.IsNull() {
+		t.Errorf("expected connector_ids to stay null, got %s", result.ConnectorIDs)
+	}
+	if !reflect.DeepEqual(result.DestinationMapping, config.DestinationMapping) {
+		t.Errorf("expected destination_mapping %v, got %v", config.DestinationMapping, result.DestinationMapping)
+	}
+
+	// Mappings removed outside Terraform are detected as drift
+	apiConfig["destination_mapping"] = []interface{}{}
+	if result := fivetranConfigurationFromAPI(config, apiConfig); len(result.DestinationMapping) != 0 {
+		t.Errorf("expected destination_mapping drift to be detected, got %v", result.DestinationMapping)
+	}
+}
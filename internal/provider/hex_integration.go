@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -12,6 +13,7 @@ import (
 // Ensure HexIntegrationResource satisfies various resource interfaces.
 var _ resource.Resource = &HexIntegrationResource{}
 var _ resource.ResourceWithImportState = &HexIntegrationResource{}
+var _ resource.ResourceWithValidateConfig = &HexIntegrationResource{}
 
 // HexIntegrationResourceModel describes the Hex integration resource data model.
 type HexIntegrationResourceModel struct {
@@ -21,10 +23,14 @@ type HexIntegrationResourceModel struct {
 
 // HexConfigurationModel describes the Hex-specific configuration
 type HexConfigurationModel struct {
-	APIToken      types.String `tfsdk:"api_token"`
-	BaseURL       types.String `tfsdk:"base_url"`
-	WorkspaceID   types.String `tfsdk:"workspace_id"`
-	WorkspaceName types.String `tfsdk:"workspace_name"`
+	APIToken           types.String `tfsdk:"api_token"`
+	BaseURL            types.String `tfsdk:"base_url"`
+	WorkspaceID        types.String `tfsdk:"workspace_id"`
+	WorkspaceName      types.String `tfsdk:"workspace_name"`
+	IncludeProjects    types.List   `tfsdk:"include_projects"`
+	ExcludeProjects    types.List   `tfsdk:"exclude_projects"`
+	IncludeCollections types.List   `tfsdk:"include_collections"`
+	ExcludeStatuses    types.List   `tfsdk:"exclude_statuses"`
 }
 
 // HexIntegrationResource defines the Hex integration resource implementation.
@@ -34,7 +40,9 @@ type HexIntegrationResource struct {
 
 // NewHexIntegrationResource is a helper function to simplify the provider server and testing implementation.
 func NewHexIntegrationResource() resource.Resource {
-	return &HexIntegrationResource{}
+	return &HexIntegrationResource{
+		BaseIntegrationResource: BaseIntegrationResource{integrationType: "hex"},
+	}
 }
 
 // Metadata returns the resource type name.
@@ -47,8 +55,8 @@ func (r *HexIntegrationResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Euno Hex Integration resource",
 
-		Attributes: getCommonAttributes(),
-		Blocks:     getCommonBlocks(),
+		Attributes: getCommonAttributesForPull(),
+		Blocks:     getCommonBlocks(ctx),
 	}
 
 	// Add Hex-specific configuration block
@@ -74,10 +82,141 @@ func (r *HexIntegrationResource) Schema(ctx context.Context, req resource.Schema
 				Computed:            true,
 				MarkdownDescription: "Hex workspace name (defaults to hex_workspace)",
 			},
+			"include_projects": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Project names or IDs to crawl, as names, glob patterns or regular expressions enclosed in slashes. All projects are crawled when not set",
+			},
+			"exclude_projects": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Project names or IDs to skip, as names, glob patterns or regular expressions enclosed in slashes. Takes precedence over include_projects",
+			},
+			"include_collections": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Only crawl projects in these collections, as names, glob patterns or regular expressions enclosed in slashes",
+			},
+			"exclude_statuses": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Skip projects with these statuses, as configured in the Hex workspace (e.g. `In development`)",
+			},
 		},
 	}
 }
 
+// ValidateConfig validates the project filters at plan time.
+func (r *HexIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *HexConfigurationModel
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("configuration"), &config)...)
+
+	if resp.Diagnostics.HasError() || config == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePatternList(config.IncludeProjects, path.Root("configuration").AtName("include_projects"))...)
+	resp.Diagnostics.Append(validatePatternList(config.ExcludeProjects, path.Root("configuration").AtName("exclude_projects"))...)
+	resp.Diagnostics.Append(validatePatternList(config.IncludeCollections, path.Root("configuration").AtName("include_collections"))...)
+
+	for i, elem := range config.ExcludeStatuses.Elements() {
+		if status, ok := elem.(types.String); ok && !status.IsUnknown() && status.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("configuration").AtName("exclude_statuses").AtListIndex(i),
+				"Invalid Hex Configuration",
+				"Project statuses must not be empty",
+			)
+		}
+	}
+
+	// A project that is both included and excluded is never crawled, which is almost certainly a mistake
+	excluded := map[string]bool{}
+	for _, project := range convertStringListToAPI(config.ExcludeProjects) {
+		excluded[project] = true
+	}
+	for i, elem := range config.IncludeProjects.Elements() {
+		if project, ok := elem.(types.String); ok && !project.IsUnknown() && excluded[project.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("configuration").AtName("include_projects").AtListIndex(i),
+				"Invalid Hex Configuration",
+				fmt.Sprintf("Project %q is both included and excluded", project.ValueString()),
+			)
+		}
+	}
+}
+
+// hexConfigurationToAPI converts the Terraform Hex configuration to API format
+func hexConfigurationToAPI(config HexConfigurationModel) map[string]interface{} {
+	configMap := map[string]interface{}{
+		"api_token":    config.APIToken.ValueString(),
+		"workspace_id": config.WorkspaceID.ValueString(),
+	}
+
+	if !config.BaseURL.IsNull() && !config.BaseURL.IsUnknown() {
+		configMap["base_url"] = config.BaseURL.ValueString()
+	}
+	if !config.WorkspaceName.IsNull() && !config.WorkspaceName.IsUnknown() {
+		configMap["workspace_name"] = config.WorkspaceName.ValueString()
+	}
+	if !config.IncludeProjects.IsNull() {
+		configMap["include_projects"] = convertStringListToAPI(config.IncludeProjects)
+	}
+	if !config.ExcludeProjects.IsNull() {
+		configMap["exclude_projects"] = convertStringListToAPI(config.ExcludeProjects)
+	}
+	if !config.IncludeCollections.IsNull() {
+		configMap["include_collections"] = convertStringListToAPI(config.IncludeCollections)
+	}
+	if !config.ExcludeStatuses.IsNull() {
+		configMap["exclude_statuses"] = convertStringListToAPI(config.ExcludeStatuses)
+	}
+
+	return configMap
+}
+
+// hexConfigurationFromAPI maps the configuration returned by the API onto the current
+// configuration. Computed values the API does not return are resolved to null.
+func hexConfigurationFromAPI(current HexConfigurationModel, apiConfig map[string]interface{}) HexConfigurationModel {
+	config := current
+
+	if apiToken, ok := apiConfig["api_token"].(string); ok {
+		config.APIToken = types.StringValue(apiToken)
+	}
+	if baseURL, ok := apiConfig["base_url"].(string); ok {
+		config.BaseURL = types.StringValue(baseURL)
+	} else if config.BaseURL.IsUnknown() {
+		config.BaseURL = types.StringNull()
+	}
+	if workspaceID, ok := apiConfig["workspace_id"].(string); ok {
+		config.WorkspaceID = types.StringValue(workspaceID)
+	}
+	if workspaceName, ok := apiConfig["workspace_name"].(string); ok {
+		config.WorkspaceName = types.StringValue(workspaceName)
+	} else if config.WorkspaceName.IsUnknown() {
+		config.WorkspaceName = types.StringNull()
+	}
+
+	filters := map[string]*types.List{
+		"include_projects":    &config.IncludeProjects,
+		"exclude_projects":    &config.ExcludeProjects,
+		"include_collections": &config.IncludeCollections,
+		"exclude_statuses":    &config.ExcludeStatuses,
+	}
+	for key, target := range filters {
+		v, ok := apiConfig[key]
+		if !ok {
+			continue
+		}
+		// An empty list is equivalent to not setting the filter
+		if list := convertStringListFromAPI(v); len(list.Elements()) > 0 || !target.IsNull() {
+			*target = list
+		}
+	}
+
+	return config
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *HexIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data HexIntegrationResourceModel
@@ -89,22 +228,22 @@ func (r *HexIntegrationResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	// Convert configuration to API format
-	configMap := map[string]interface{}{
-		"api_token":    data.Configuration.APIToken.ValueString(),
-		"workspace_id": data.Configuration.WorkspaceID.ValueString(),
-	}
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
 
-	if !data.Configuration.BaseURL.IsNull() {
-		configMap["base_url"] = data.Configuration.BaseURL.ValueString()
-	}
-	if !data.Configuration.WorkspaceName.IsNull() {
-		configMap["workspace_name"] = data.Configuration.WorkspaceName.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Convert configuration to API format
+	configMap := hexConfigurationToAPI(data.Configuration)
+
 	// Convert Terraform data to API format
 	integration := IntegrationIn{
-		IntegrationType:      "hex",
+		IntegrationType:      r.integrationType,
 		Name:                 data.Name.ValueString(),
 		Active:               data.Active.ValueBool(),
 		Configuration:        configMap,
@@ -115,7 +254,7 @@ func (r *HexIntegrationResource) Create(ctx context.Context, req resource.Create
 	// Create the integration
 	result, err := r.client.CreateIntegration(ctx, integration)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Hex integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create Hex integration", err)
 		return
 	}
 
@@ -127,22 +266,10 @@ func (r *HexIntegrationResource) Create(ctx context.Context, req resource.Create
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
 	// Convert configuration back to Terraform format
-	if result.Configuration != nil {
-		if apiToken, ok := result.Configuration["api_token"].(string); ok {
-			data.Configuration.APIToken = types.StringValue(apiToken)
-		}
-		if baseURL, ok := result.Configuration["base_url"].(string); ok {
-			data.Configuration.BaseURL = types.StringValue(baseURL)
-		}
-		if workspaceID, ok := result.Configuration["workspace_id"].(string); ok {
-			data.Configuration.WorkspaceID = types.StringValue(workspaceID)
-		}
-		if workspaceName, ok := result.Configuration["workspace_name"].(string); ok {
-			data.Configuration.WorkspaceName = types.StringValue(workspaceName)
-		}
-	}
+	data.Configuration = hexConfigurationFromAPI(data.Configuration, result.Configuration)
 
 	// Convert schedule and invalidation strategy back
 	data.Schedule = convertScheduleFromAPI(result.Schedule)
@@ -152,6 +279,11 @@ func (r *HexIntegrationResource) Create(ctx context.Context, req resource.Create
 		data.PendingCredentialsLookupKey = types.StringValue(*result.PendingCredentialsLookupKey)
 	}
 
+	// Wait for a run to complete when requested
+	if data.WaitForFirstRun.ValueBool() {
+		resp.Diagnostics.Append(r.waitForFirstRun(ctx, &data.BaseIntegrationResourceModel, "Hex")...)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -167,10 +299,20 @@ func (r *HexIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the integration from the API
 	result, err := r.client.GetIntegration(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Hex integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read Hex integration", err)
 		return
 	}
 
@@ -182,22 +324,10 @@ func (r *HexIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
 	// Convert configuration back to Terraform format
-	if result.Configuration != nil {
-		if apiToken, ok := result.Configuration["api_token"].(string); ok {
-			data.Configuration.APIToken = types.StringValue(apiToken)
-		}
-		if baseURL, ok := result.Configuration["base_url"].(string); ok {
-			data.Configuration.BaseURL = types.StringValue(baseURL)
-		}
-		if workspaceID, ok := result.Configuration["workspace_id"].(string); ok {
-			data.Configuration.WorkspaceID = types.StringValue(workspaceID)
-		}
-		if workspaceName, ok := result.Configuration["workspace_name"].(string); ok {
-			data.Configuration.WorkspaceName = types.StringValue(workspaceName)
-		}
-	}
+	data.Configuration = hexConfigurationFromAPI(data.Configuration, result.Configuration)
 
 	// Convert schedule and invalidation strategy back
 	data.Schedule = convertScheduleFromAPI(result.Schedule)
@@ -222,22 +352,22 @@ func (r *HexIntegrationResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	// Convert configuration to API format
-	configMap := map[string]interface{}{
-		"api_token":    data.Configuration.APIToken.ValueString(),
-		"workspace_id": data.Configuration.WorkspaceID.ValueString(),
-	}
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
 
-	if !data.Configuration.BaseURL.IsNull() {
-		configMap["base_url"] = data.Configuration.BaseURL.ValueString()
-	}
-	if !data.Configuration.WorkspaceName.IsNull() {
-		configMap["workspace_name"] = data.Configuration.WorkspaceName.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Convert configuration to API format
+	configMap := hexConfigurationToAPI(data.Configuration)
+
 	// Convert Terraform data to API format
 	integration := IntegrationIn{
-		IntegrationType:      "hex",
+		IntegrationType:      r.integrationType,
 		Name:                 data.Name.ValueString(),
 		Active:               data.Active.ValueBool(),
 		Configuration:        configMap,
@@ -248,7 +378,7 @@ func (r *HexIntegrationResource) Update(ctx context.Context, req resource.Update
 	// Update the integration
 	result, err := r.client.UpdateIntegration(ctx, int(data.ID.ValueInt64()), integration)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Hex integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update Hex integration", err)
 		return
 	}
 
@@ -260,22 +390,10 @@ func (r *HexIntegrationResource) Update(ctx context.Context, req resource.Update
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
 	// Convert configuration back to Terraform format
-	if result.Configuration != nil {
-		if apiToken, ok := result.Configuration["api_token"].(string); ok {
-			data.Configuration.APIToken = types.StringValue(apiToken)
-		}
-		if baseURL, ok := result.Configuration["base_url"].(string); ok {
-			data.Configuration.BaseURL = types.StringValue(baseURL)
-		}
-		if workspaceID, ok := result.Configuration["workspace_id"].(string); ok {
-			data.Configuration.WorkspaceID = types.StringValue(workspaceID)
-		}
-		if workspaceName, ok := result.Configuration["workspace_name"].(string); ok {
-			data.Configuration.WorkspaceName = types.StringValue(workspaceName)
-		}
-	}
+	data.Configuration = hexConfigurationFromAPI(data.Configuration, result.Configuration)
 
 	// Convert schedule and invalidation strategy back
 	data.Schedule = convertScheduleFromAPI(result.Schedule)
@@ -285,6 +403,11 @@ func (r *HexIntegrationResource) Update(ctx context.Context, req resource.Update
 		data.PendingCredentialsLookupKey = types.StringValue(*result.PendingCredentialsLookupKey)
 	}
 
+	// Wait for a run to complete when requested
+	if data.WaitForFirstRun.ValueBool() {
+		resp.Diagnostics.Append(r.waitForFirstRun(ctx, &data.BaseIntegrationResourceModel, "Hex")...)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -300,10 +423,20 @@ func (r *HexIntegrationResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the integration
 	err := r.client.DeleteIntegration(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Hex integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete Hex integration", err)
 		return
 	}
 }
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHexConfigurationRoundTrip(t *testing.T) {
+	config := HexConfigurationModel{
+		APIToken:           types.StringValue("token"),
+		WorkspaceID:        types.StringValue("workspace"),
+		BaseURL:            types.StringUnknown(),
+		WorkspaceName:      types.StringUnknown(),
+		IncludeProjects:    types.ListNull(types.StringType),
+		ExcludeProjects:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Scratch *")}),
+		IncludeCollections: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Finance")}),
+		ExcludeStatuses:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("In development")}),
+	}
+
+	configMap := hexConfigurationToAPI(config)
+	if _, ok := configMap["base_url"]; ok {
+		t.Errorf("expected unknown base_url not to be sent, got %v", configMap["base_url"])
+	}
+	if _, ok := configMap["workspace_name"]; ok {
+		t.Errorf("expected unknown workspace_name not to be sent, got %v", configMap["workspace_name"])
+	}
+	if _, ok := configMap["include_projects"]; ok {
+		t.Errorf("expected null include_projects not to be sent, got %v", configMap["include_projects"])
+	}
+
+	// Simulate the JSON round trip through the API, which also returns defaults
+	apiConfig := map[string]interface{}{
+		"api_token":           "token",
+		"workspace_id":        "workspace",
+		"workspace_name":      "hex_workspace",
+		"include_projects":    []interface{}{},
+		"exclude_projects":    []interface{}{"Scratch *"},
+		"include_collections": []interface{}{"Finance", "Marketing"},
+	}
+
+	result := hexConfigurationFromAPI(config, apiConfig)
+
+	if !result.BaseURL.IsNull() {
+		t.Errorf("expected unreturned base_url to be null, got %s", result.BaseURL)
+	}
+	if !result.WorkspaceName.Equal(types.StringValue("hex_workspace")) {
+		t.Errorf("expected workspace_name hex_workspace, got %s", result.WorkspaceName)
+	}
+	if !result.IncludeProjects.IsNull() {
+		t.Errorf("expected empty include_projects to stay null, got %s", result.IncludeProjects)
+	}
+	if !result.ExcludeProjects.Equal(config.ExcludeProjects) {
+		t.Errorf("expected exclude_projects %s, got %s", config.ExcludeProjects, result.ExcludeProjects)
+	}
+	if expected := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Finance"), types.StringValue("Marketing")}); !result.IncludeCollections.Equal(expected) {
+		t.Errorf("expected include_collections drift to be detected, got %s", result.IncludeCollections)
+	}
+	if !result.ExcludeStatuses.Equal(config.ExcludeStatuses) {
+		t.Errorf("expected exclude_statuses to be kept when not returned, got %s", result.ExcludeStatuses)
+	}
+}
@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IntegrationDataSource{}
+var _ datasource.DataSourceWithConfigure = &IntegrationDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &IntegrationDataSource{}
+
+// NewIntegrationDataSource is a helper function to simplify the provider implementation.
+func NewIntegrationDataSource() datasource.DataSource {
+	return &IntegrationDataSource{}
+}
+
+// IntegrationDataSource defines the data source implementation.
+type IntegrationDataSource struct {
+	client *EunoClient
+}
+
+// IntegrationDataSourceModel describes the data source data model.
+type IntegrationDataSourceModel struct {
+	ID                          types.Int64                `tfsdk:"id"`
+	Name                        types.String               `tfsdk:"name"`
+	IntegrationType             types.String               `tfsdk:"integration_type"`
+	AccountID                   types.Int64                `tfsdk:"account_id"`
+	Active                      types.Bool                 `tfsdk:"active"`
+	Configuration               types.String               `tfsdk:"configuration"`
+	Schedule                    *ScheduleModel             `tfsdk:"schedule"`
+	InvalidationStrategy        *InvalidationStrategyModel `tfsdk:"invalidation_strategy"`
+	TriggerSecret               types.String               `tfsdk:"trigger_secret"`
+	TriggerURL                  types.String               `tfsdk:"trigger_url"`
+	PendingCredentialsLookupKey types.String               `tfsdk:"pending_credentials_lookup_key"`
+	LastRunError                types.String               `tfsdk:"last_run_error"`
+	LastUpdatedAt               types.String               `tfsdk:"last_updated_at"`
+	CreatedAt                   types.String               `tfsdk:"created_at"`
+	IntegrationStatusModel
+}
+
+// Metadata returns the data source type name.
+func (d *IntegrationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+// Schema defines the schema for the data source.
+func (d *IntegrationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an Euno integration by ID, or by name and integration type",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the integration. Conflicts with `name`",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the integration. Requires `integration_type`",
+			},
+			"integration_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The type of the integration, such as `snowflake`, `fivetran`, `hex` or `dbt_core`",
+			},
+			"account_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the account the integration belongs to",
+			},
+			"active": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the integration is active",
+			},
+			"configuration": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The configuration of the integration, encoded as JSON. Use `jsondecode` to access its fields",
+			},
+			"schedule": dataSourceScheduleAttribute(),
+			"invalidation_strategy": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The invalidation strategy of the integration",
+				Attributes: map[string]schema.Attribute{
+					"revision_id": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The revision ID for invalidation",
+					},
+					"ttl_days": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "The TTL in days for invalidation",
+					},
+				},
+			},
+			"trigger_secret": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret key for triggering the integration",
+			},
+			"trigger_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The URL for triggering the integration",
+			},
+			"pending_credentials_lookup_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The pending credentials lookup key",
+			},
+			"last_run_error": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The error of the last integration run",
+			},
+			"last_updated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The last updated timestamp",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The creation timestamp",
+			},
+			"created_by": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user who created the integration",
+			},
+			"last_updated_by": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The user who last updated the integration",
+			},
+			"last_run_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the last integration run",
+			},
+			"health": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The health of the integration",
+			},
+			"last_completed_run_end_time": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The end timestamp of the last completed run",
+			},
+			"trigger_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "How the last run was triggered",
+			},
+			"last_time_triggered": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp the integration was last triggered",
+			},
+			"collected_integration_data": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Data collected by the integration's last run, encoded as JSON. Use `jsondecode` to access its fields",
+			},
+		},
+	}
+}
+
+// dataSourceScheduleAttribute returns the computed schedule attribute of integration data sources
+func dataSourceScheduleAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The schedule of the integration",
+		Attributes: map[string]schema.Attribute{
+			"time_zone": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time zone of the schedule",
+			},
+			"repeat_on": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The days the integration runs on",
+			},
+			"repeat_time": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time of day the integration runs at",
+			},
+			"repeat_period": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The repeat period in hours",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IntegrationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EunoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EunoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that the integration is identified either by ID or by name and type.
+func (d *IntegrationDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data IntegrationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.Name.IsUnknown() || data.IntegrationType.IsUnknown() {
+		return
+	}
+
+	switch {
+	case !data.ID.IsNull() && !data.Name.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Conflicting Integration Lookup",
+			"Only one of id or name can be set.",
+		)
+	case data.ID.IsNull() && data.Name.IsNull():
+		resp.Diagnostics.AddError(
+			"Missing Integration Lookup",
+			"Either id, or name and integration_type, must be set.",
+		)
+	case !data.Name.IsNull() && data.IntegrationType.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("integration_type"),
+			"Missing Integration Type",
+			"integration_type must be set when looking up an integration by name.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *IntegrationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IntegrationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result *IntegrationOut
+	if !data.ID.IsNull() {
+		integration, err := d.client.GetIntegration(ctx, int(data.ID.ValueInt64()))
+		if err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read integration %d", data.ID.ValueInt64()), err)
+			return
+		}
+		result = integration
+	} else {
+		integrations, err := d.client.ListIntegrations(ctx, data.IntegrationType.ValueString())
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Unable to list integrations", err)
+			return
+		}
+
+		integration, err := findIntegrationByName(integrations, data.Name.ValueString(), data.IntegrationType.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Integration Lookup Error", err.Error())
+			return
+		}
+		result = integration
+	}
+
+	if !data.IntegrationType.IsNull() && result.IntegrationType != data.IntegrationType.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("integration_type"),
+			"Integration Type Mismatch",
+			fmt.Sprintf("Integration %d is a %s integration, not %s.", result.ID, result.IntegrationType, data.IntegrationType.ValueString()),
+		)
+		return
+	}
+
+	// Map the response to the model
+	resp.Diagnostics.Append(convertIntegrationDataSourceFromAPI(&data, result)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findIntegrationByName returns the single integration with the given name and type
+func findIntegrationByName(integrations []IntegrationOut, name, integrationType string) (*IntegrationOut, error) {
+	var matches []*IntegrationOut
+	for i := range integrations {
+		if integrations[i].Name == name && integrations[i].IntegrationType == integrationType {
+			matches = append(matches, &integrations[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no %s integration named %q was found", integrationType, name)
+	case 1:
+		return matches[0], nil
+	}
+
+	ids := make([]string, len(matches))
+	for i, match := range matches {
+		ids[i] = fmt.Sprint(match.ID)
+	}
+
+	return nil, fmt.Errorf("%d %s integrations are named %q (IDs %s), look the integration up by id instead", len(matches), integrationType, name, strings.Join(ids, ", "))
+}
+
+// convertIntegrationDataSourceFromAPI maps an API integration to the data source model
+func convertIntegrationDataSourceFromAPI(data *IntegrationDataSourceModel, result *IntegrationOut) (diags diag.Diagnostics) {
+	data.ID = types.Int64Value(int64(result.ID))
+	data.Name = types.StringValue(result.Name)
+	data.IntegrationType = types.StringValue(result.IntegrationType)
+	data.AccountID = types.Int64Value(int64(result.AccountID))
+	data.Active = types.BoolPointerValue(result.Active)
+	data.Schedule = convertScheduleFromAPI(result.Schedule)
+	data.InvalidationStrategy = convertInvalidationStrategyFromAPI(result.InvalidationStrategy)
+	data.TriggerSecret = types.StringPointerValue(result.TriggerSecret)
+	data.TriggerURL = types.StringPointerValue(result.TriggerURL)
+	data.PendingCredentialsLookupKey = types.StringPointerValue(result.PendingCredentialsLookupKey)
+	data.LastRunError = types.StringPointerValue(result.LastRunError)
+	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.CreatedAt = types.StringValue(result.CreatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
+
+	// The configuration differs per integration type, so it is exposed as a JSON document
+	data.Configuration = types.StringNull()
+	if result.Configuration != nil {
+		configuration, err := json.Marshal(result.Configuration)
+		if err != nil {
+			diags.AddError("Conversion Error", fmt.Sprintf("Unable to encode the configuration of integration %d: %s", result.ID, err))
+			return diags
+		}
+		data.Configuration = types.StringValue(string(configuration))
+	}
+
+	return diags
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestListIntegrationsPagination(t *testing.T) {
+	const total = integrationPageSize + 5
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/1/integrations" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.URL.Query().Get("integration_type"); got != "snowflake" {
+			t.Errorf("expected integration_type snowflake, got %q", got)
+		}
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		result := IntegrationPage{Total: total}
+		for id := (page-1)*integrationPageSize + 1; id <= total && id <= page*integrationPageSize; id++ {
+			result.Items = append(result.Items, IntegrationOut{ID: id, IntegrationType: "snowflake"})
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client := NewEunoClient(server.URL, "key", 1)
+	integrations, err := client.ListIntegrations(context.Background(), "snowflake")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(integrations) != total {
+		t.Fatalf("expected %d integrations, got %d", total, len(integrations))
+	}
+	if integrations[total-1].ID != total {
+		t.Errorf("expected last integration %d, got %d", total, integrations[total-1].ID)
+	}
+}
+
+func TestListIntegrationsIgnoredPage(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		// Every request returns the same full page without a total
+		result := IntegrationPage{}
+		for id := 1; id <= integrationPageSize; id++ {
+			result.Items = append(result.Items, IntegrationOut{ID: id, IntegrationType: "snowflake"})
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client := NewEunoClient(server.URL, "key", 1)
+	_, err := client.ListIntegrations(context.Background(), "")
+	if err == nil || err.Error() != "page 2 of the integrations repeats the previous page" {
+		t.Fatalf("expected repeated page error, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestFindIntegrationByName(t *testing.T) {
+	integrations := []IntegrationOut{
+		{ID: 1, Name: "warehouse", IntegrationType: "snowflake"},
+		{ID: 2, Name: "warehouse", IntegrationType: "fivetran"},
+		{ID: 3, Name: "sandbox", IntegrationType: "snowflake"},
+		{ID: 4, Name: "sandbox", IntegrationType: "snowflake"},
+	}
+
+	tests := []struct {
+		name            string
+		integrationName string
+		integrationType string
+		expectedID      int
+		expectedErr     string
+	}{
+		{name: "unique", integrationName: "warehouse", integrationType: "snowflake", expectedID: 1},
+		{name: "same name other type", integrationName: "warehouse", integrationType: "fivetran", expectedID: 2},
+		{name: "not found", integrationName: "warehouse", integrationType: "hex", expectedErr: `no hex integration named "warehouse" was found`},
+		{name: "ambiguous", integrationName: "sandbox", integrationType: "snowflake", expectedErr: `2 snowflake integrations are named "sandbox" (IDs 3, 4), look the integration up by id instead`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := findIntegrationByName(integrations, tt.integrationName, tt.integrationType)
+			if tt.expectedErr != "" {
+				if err == nil || err.Error() != tt.expectedErr {
+					t.Fatalf("expected error %q, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if result.ID != tt.expectedID {
+				t.Errorf("expected integration %d, got %d", tt.expectedID, result.ID)
+			}
+		})
+	}
+}
+
+func TestConvertIntegrationDataSourceFromAPI(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	NewIntegrationDataSource().Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	active := true
+	secret := "secret"
+	result := &IntegrationOut{
+		ID:              7,
+		Name:            "dbt",
+		IntegrationType: "dbt_core",
+		Active:          &active,
+		Configuration:   map[string]interface{}{"build_target": "prod"},
+		Schedule:        &IntegrationSchedule{TimeZone: "UTC", RepeatTime: "06:00"},
+		TriggerSecret:   &secret,
+	}
+
+	var data IntegrationDataSourceModel
+	if diags := convertIntegrationDataSourceFromAPI(&data, result); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := data.Configuration.ValueString(); got != `{"build_target":"prod"}` {
+		t.Errorf("unexpected configuration %s", got)
+	}
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("unable to set state: %v", diags)
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// runStatusSuccess is the last_run_status reported for a successful run
+const runStatusSuccess = "success"
+
+// runPollInterval is the interval between integration status checks while waiting for a run
+var runPollInterval = 10 * time.Second
+
+// runIntegrationAndWait triggers a run of the integration, or awaits the run already
+// in progress, and polls the integration until that run completes.
+func runIntegrationAndWait(ctx context.Context, client *EunoClient, integration *IntegrationOut) (*IntegrationOut, error) {
+	if _, err := client.RunIntegration(ctx, integration.ID); err != nil && !errors.Is(err, ErrRunInProgress) {
+		return nil, fmt.Errorf("failed to trigger run: %w", err)
+	}
+
+	return waitForIntegrationRun(ctx, client, integration.ID, integration.LastCompletedRunEndTime, nil)
+}
+
+// waitForIntegrationRun polls the integration until a run completes after previousEndTime.
+// A run that does not succeed is returned together with an error describing the failure.
+// When set, progress is called with the integration after each poll that finds the run in progress.
+func waitForIntegrationRun(ctx context.Context, client *EunoClient, integrationID int, previousEndTime *string, progress func(*IntegrationOut)) (*IntegrationOut, error) {
+	ticker := time.NewTicker(runPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for integration run to complete: %w", ctx.Err())
+		case <-ticker.C:
+		}
+
+		result, err := client.GetIntegration(ctx, integrationID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("timed out waiting for integration run to complete: %w", ctx.Err())
+			}
+			return nil, err
+		}
+
+		// The run is still in progress until a new completed run end time is reported
+		if result.LastCompletedRunEndTime == nil || (previousEndTime != nil && *result.LastCompletedRunEndTime == *previousEndTime) {
+			if progress != nil {
+				progress(result)
+			}
+			continue
+		}
+
+		if result.LastRunStatus != nil && strings.EqualFold(*result.LastRunStatus, runStatusSuccess) {
+			return result, nil
+		}
+
+		return result, runFailureError(result)
+	}
+}
+
+// runFailureError describes a failed run using the status, health and error reported by Euno
+func runFailureError(result *IntegrationOut) error {
+	status := "unknown"
+	if result.LastRunStatus != nil {
+		status = *result.LastRunStatus
+	}
+
+	msg := fmt.Sprintf("run finished with status %q", status)
+	if result.Health != nil {
+		msg += fmt.Sprintf(" (health %q)", *result.Health)
+	}
+	if result.LastRunError != nil && *result.LastRunError != "" {
+		msg += ": " + *result.LastRunError
+	}
+
+	return errors.New(msg)
+}
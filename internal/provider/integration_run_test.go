@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRunTestServer(t *testing.T, finalStatus, runError string) *httptest.Server {
+	t.Helper()
+
+	var polls atomic.Int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/integrations/7/run"):
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(IntegrationRun{ID: 1, IntegrationID: 7, Status: "running"})
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/integrations/7"):
+			out := IntegrationOut{ID: 7, Name: "test"}
+			// Report the previous run until the second poll
+			end := "2026-01-01T00:00:00Z"
+			if polls.Add(1) >= 2 {
+				end = "2026-01-02T00:00:00Z"
+				out.LastRunStatus = &finalStatus
+				if runError != "" {
+					out.LastRunError = &runError
+				}
+			}
+			out.LastCompletedRunEndTime = &end
+			_ = json.NewEncoder(w).Encode(out)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// setRunPollInterval shortens the poll interval for the duration of a test
+func setRunPollInterval(t *testing.T, interval time.Duration) {
+	t.Helper()
+
+	previous := runPollInterval
+	runPollInterval = interval
+	t.Cleanup(func() { runPollInterval = previous })
+}
+
+func TestRunIntegrationAndWait(t *testing.T) {
+	setRunPollInterval(t, time.Millisecond)
+	previousEnd := "2026-01-01T00:00:00Z"
+
+	tests := []struct {
+		name        string
+		status      string
+		runError    string
+		expectedErr string
+	}{
+		{name: "success", status: "success"},
+		{name: "failure", status: "failed", runError: "insufficient privileges", expectedErr: `run finished with status "failed": insufficient privileges`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRunTestServer(t, tt.status, tt.runError)
+			defer server.Close()
+
+			client := NewEunoClient(server.URL, "key", 1)
+			result, err := runIntegrationAndWait(context.Background(), client, &IntegrationOut{ID: 7, LastCompletedRunEndTime: &previousEnd})
+
+			if tt.expectedErr == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tt.expectedErr != "" && (err == nil || err.Error() != tt.expectedErr) {
+				t.Fatalf("expected error %q, got %v", tt.expectedErr, err)
+			}
+			if result == nil || *result.LastRunStatus != tt.status {
+				t.Fatalf("expected final status %q, got %+v", tt.status, result)
+			}
+		})
+	}
+}
+
+func TestRunIntegrationAndWaitTimeout(t *testing.T) {
+	setRunPollInterval(t, time.Millisecond)
+	previousEnd := "2026-01-01T00:00:00Z"
+
+	// The server keeps reporting the previous run, so the wait never completes
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(IntegrationOut{ID: 7, LastCompletedRunEndTime: &previousEnd})
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	client := NewEunoClient(server.URL, "key", 1)
+	_, err := runIntegrationAndWait(ctx, client, &IntegrationOut{ID: 7, LastCompletedRunEndTime: &previousEnd})
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultIntegrationRunsLimit is the number of runs returned when limit is not set
+const defaultIntegrationRunsLimit = 10
+
+// maxIntegrationRunsLimit is the largest number of runs that can be requested
+const maxIntegrationRunsLimit = 1000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IntegrationRunsDataSource{}
+var _ datasource.DataSourceWithConfigure = &IntegrationRunsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &IntegrationRunsDataSource{}
+
+// NewIntegrationRunsDataSource is a helper function to simplify the provider implementation.
+func NewIntegrationRunsDataSource() datasource.DataSource {
+	return &IntegrationRunsDataSource{}
+}
+
+// IntegrationRunsDataSource defines the data source implementation.
+type IntegrationRunsDataSource struct {
+	client *EunoClient
+}
+
+// IntegrationRunsDataSourceModel describes the data source data model.
+type IntegrationRunsDataSourceModel struct {
+	IntegrationID types.Int64           `tfsdk:"integration_id"`
+	Limit         types.Int64           `tfsdk:"limit"`
+	Since         types.String          `tfsdk:"since"`
+	Runs          []IntegrationRunModel `tfsdk:"runs"`
+}
+
+// IntegrationRunModel describes a run returned by the euno_integration_runs data source
+type IntegrationRunModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	Status         types.String `tfsdk:"status"`
+	TriggerType    types.String `tfsdk:"trigger_type"`
+	StartTime      types.String `tfsdk:"start_time"`
+	EndTime        types.String `tfsdk:"end_time"`
+	ErrorMessage   types.String `tfsdk:"error_message"`
+	ResourceCounts types.Map    `tfsdk:"resource_counts"`
+}
+
+// Metadata returns the data source type name.
+func (d *IntegrationRunsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_runs"
+}
+
+// Schema defines the schema for the data source.
+func (d *IntegrationRunsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the most recent runs of an Euno integration",
+
+		Attributes: map[string]schema.Attribute{
+			"integration_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the integration",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The maximum number of runs to return, between 1 and %d. Defaults to %d", maxIntegrationRunsLimit, defaultIntegrationRunsLimit),
+			},
+			"since": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return runs started at or after this RFC 3339 timestamp",
+			},
+			"runs": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The runs of the integration, newest first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the run",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the run",
+						},
+						"trigger_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "How the run was triggered",
+						},
+						"start_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The start timestamp of the run",
+						},
+						"end_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The end timestamp of the run, null while it is in progress",
+						},
+						"error_message": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The error of the run",
+						},
+						"resource_counts": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.Int64Type,
+							MarkdownDescription: "The number of resources collected by the run, by resource type",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IntegrationRunsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EunoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EunoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks the limit and since arguments.
+func (d *IntegrationRunsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data IntegrationRunsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Limit.IsNull() && !data.Limit.IsUnknown() {
+		if limit := data.Limit.ValueInt64(); limit < 1 || limit > maxIntegrationRunsLimit {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("limit"),
+				"Invalid Limit",
+				fmt.Sprintf("limit must be between 1 and %d, got %d.", maxIntegrationRunsLimit, limit),
+			)
+		}
+	}
+
+	if !data.Since.IsNull() && !data.Since.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, data.Since.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("since"),
+				"Invalid Timestamp",
+				fmt.Sprintf("since must be an RFC 3339 timestamp such as 2026-01-02T15:04:05Z, got %q.", data.Since.ValueString()),
+			)
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *IntegrationRunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IntegrationRunsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := defaultIntegrationRunsLimit
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	runs, err := d.client.ListIntegrationRuns(ctx, int(data.IntegrationID.ValueInt64()), limit, data.Since.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to list the runs of integration %d", data.IntegrationID.ValueInt64()), err)
+		return
+	}
+
+	// Map the runs to the model
+	data.Runs = make([]IntegrationRunModel, len(runs))
+	for i, run := range runs {
+		data.Runs[i] = convertIntegrationRunFromAPI(run)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// convertIntegrationRunFromAPI converts an API run to Terraform format
+func convertIntegrationRunFromAPI(run IntegrationRun) IntegrationRunModel {
+	resourceCounts := types.MapNull(types.Int64Type)
+	if run.ResourceCounts != nil {
+		counts := make(map[string]attr.Value, len(run.ResourceCounts))
+		for resourceType, count := range run.ResourceCounts {
+			counts[resourceType] = types.Int64Value(count)
+		}
+		resourceCounts = types.MapValueMust(types.Int64Type, counts)
+	}
+
+	return IntegrationRunModel{
+		ID:             types.Int64Value(int64(run.ID)),
+		Status:         types.StringValue(run.Status),
+		TriggerType:    types.StringPointerValue(run.TriggerType),
+		StartTime:      types.StringPointerValue(run.StartTime),
+		EndTime:        types.StringPointerValue(run.EndTime),
+		ErrorMessage:   types.StringPointerValue(run.ErrorMessage),
+		ResourceCounts: resourceCounts,
+	}
+}
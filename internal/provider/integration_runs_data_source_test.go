@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListIntegrationRuns(t *testing.T) {
+	const total = 250
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/1/integrations/7/runs" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+
+		query := r.URL.Query()
+		if got := query.Get("since"); got != "2026-01-01T00:00:00Z" {
+			t.Errorf("expected since 2026-01-01T00:00:00Z, got %q", got)
+		}
+
+		page, _ := strconv.Atoi(query.Get("page"))
+		pageSize, _ := strconv.Atoi(query.Get("page_size"))
+		result := IntegrationRunPage{Total: total}
+		for id := total - (page-1)*pageSize; id > 0 && id > total-page*pageSize; id-- {
+			result.Items = append(result.Items, IntegrationRun{ID: id, IntegrationID: 7, Status: "success"})
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	defer server.Close()
+
+	client := NewEunoClient(server.URL, "key", 1)
+
+	tests := []struct {
+		name             string
+		limit            int
+		expectedRuns     int
+		expectedRequests int
+	}{
+		{name: "single page", limit: 10, expectedRuns: 10, expectedRequests: 1},
+		{name: "several pages", limit: 150, expectedRuns: 150, expectedRequests: 2},
+		{name: "more than available", limit: 1000, expectedRuns: total, expectedRequests: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+
+			runs, err := client.ListIntegrationRuns(context.Background(), 7, tt.limit, "2026-01-01T00:00:00Z")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(runs) != tt.expectedRuns {
+				t.Errorf("expected %d runs, got %d", tt.expectedRuns, len(runs))
+			}
+			if requests != tt.expectedRequests {
+				t.Errorf("expected %d requests, got %d", tt.expectedRequests, requests)
+			}
+			if len(runs) > 0 && runs[0].ID != total {
+				t.Errorf("expected newest run %d first, got %d", total, runs[0].ID)
+			}
+		})
+	}
+}
+
+func TestConvertIntegrationRunFromAPI(t *testing.T) {
+	start := "2026-01-02T00:00:00Z"
+	run := convertIntegrationRunFromAPI(IntegrationRun{
+		ID:             3,
+		Status:         "success",
+		StartTime:      &start,
+		ResourceCounts: map[string]int64{"table": 12, "view": 3},
+	})
+
+	if !run.StartTime.Equal(types.StringValue(start)) {
+		t.Errorf("unexpected start time %s", run.StartTime)
+	}
+	if !run.EndTime.IsNull() {
+		t.Errorf("expected null end time, got %s", run.EndTime)
+	}
+	if counts := run.ResourceCounts.Elements(); len(counts) != 2 || !counts["table"].Equal(types.Int64Value(12)) {
+		t.Errorf("unexpected resource counts %s", run.ResourceCounts)
+	}
+
+	if run := convertIntegrationRunFromAPI(IntegrationRun{ID: 4, Status: "running"}); !run.ResourceCounts.IsNull() {
+		t.Errorf("expected null resource counts, got %s", run.ResourceCounts)
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &IntegrationTriggerCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &IntegrationTriggerCredentialsEphemeralResource{}
+
+// NewIntegrationTriggerCredentialsEphemeralResource is a helper function to simplify the provider implementation.
+func NewIntegrationTriggerCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &IntegrationTriggerCredentialsEphemeralResource{}
+}
+
+// IntegrationTriggerCredentialsEphemeralResource defines the ephemeral resource implementation.
+type IntegrationTriggerCredentialsEphemeralResource struct {
+	client *EunoClient
+}
+
+// IntegrationTriggerCredentialsEphemeralResourceModel describes the ephemeral resource data model.
+type IntegrationTriggerCredentialsEphemeralResourceModel struct {
+	IntegrationID types.Int64  `tfsdk:"integration_id"`
+	TriggerURL    types.String `tfsdk:"trigger_url"`
+	TriggerSecret types.String `tfsdk:"trigger_secret"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *IntegrationTriggerCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_trigger_credentials"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *IntegrationTriggerCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the trigger URL and secret of a push integration without storing them in the plan or state",
+
+		Attributes: map[string]schema.Attribute{
+			"integration_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the push integration",
+			},
+			"trigger_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The URL for triggering the integration",
+			},
+			"trigger_secret": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret key for triggering the integration",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *IntegrationTriggerCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EunoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *EunoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+// Open fetches the trigger credentials of the integration.
+func (e *IntegrationTriggerCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data IntegrationTriggerCredentialsEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := e.client.GetIntegration(ctx, int(data.IntegrationID.ValueInt64()))
+	if err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read integration %d", data.IntegrationID.ValueInt64()), err)
+		return
+	}
+
+	if result.TriggerURL == nil || result.TriggerSecret == nil {
+		resp.Diagnostics.AddError(
+			"Missing Trigger Credentials",
+			fmt.Sprintf("Integration %d (%s) has no trigger credentials. Only push integrations, such as dbt_core, are triggered by URL.", result.ID, result.IntegrationType),
+		)
+		return
+	}
+
+	data.TriggerURL = types.StringValue(*result.TriggerURL)
+	data.TriggerSecret = types.StringValue(*result.TriggerSecret)
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIntegrationTriggerCredentialsOpen(t *testing.T) {
+	ctx := context.Background()
+	triggerURL := "https://api.example.com/trigger/7"
+	triggerSecret := "secret"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/integrations/7"):
+			_ = json.NewEncoder(w).Encode(IntegrationOut{ID: 7, IntegrationType: "dbt_core", TriggerURL: &triggerURL, TriggerSecret: &triggerSecret})
+		case strings.HasSuffix(r.URL.Path, "/integrations/8"):
+			_ = json.NewEncoder(w).Encode(IntegrationOut{ID: 8, IntegrationType: "snowflake"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	e := &IntegrationTriggerCredentialsEphemeralResource{client: NewEunoClient(server.URL, "key", 1)}
+
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	tests := []struct {
+		name          string
+		integrationID int64
+		expectedError string
+	}{
+		{name: "push integration", integrationID: 7},
+		{name: "pull integration", integrationID: 8, expectedError: "Missing Trigger Credentials"},
+		{name: "not found", integrationID: 9, expectedError: "Client Error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := ephemeral.OpenRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+						"integration_id": tftypes.NewValue(tftypes.Number, tt.integrationID),
+						"trigger_url":    tftypes.NewValue(tftypes.String, nil),
+						"trigger_secret": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+			}
+			resp := ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+
+			e.Open(ctx, req, &resp)
+
+			if tt.expectedError != "" {
+				if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != tt.expectedError {
+					t.Fatalf("expected error %q, got %v", tt.expectedError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var result IntegrationTriggerCredentialsEphemeralResourceModel
+			resp.Diagnostics.Append(resp.Result.Get(ctx, &result)...)
+			if !result.TriggerURL.Equal(types.StringValue(triggerURL)) || !result.TriggerSecret.Equal(types.StringValue(triggerSecret)) {
+				t.Errorf("unexpected credentials %s %s", result.TriggerURL, result.TriggerSecret)
+			}
+		})
+	}
+}
+
+func TestDbtCoreTriggerSecretFromAPI(t *testing.T) {
+	secret := "secret"
+
+	tests := []struct {
+		name     string
+		store    types.Bool
+		secret   *string
+		expected types.String
+	}{
+		{name: "default", store: types.BoolNull(), secret: &secret, expected: types.StringValue(secret)},
+		{name: "stored", store: types.BoolValue(true), secret: &secret, expected: types.StringValue(secret)},
+		{name: "not stored", store: types.BoolValue(false), secret: &secret, expected: types.StringNull()},
+		{name: "no secret", store: types.BoolNull(), expected: types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dbtCoreTriggerSecretFromAPI(tt.store, tt.secret); !got.Equal(tt.expected) {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
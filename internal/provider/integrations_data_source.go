@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IntegrationsDataSource{}
+var _ datasource.DataSourceWithConfigure = &IntegrationsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &IntegrationsDataSource{}
+
+// NewIntegrationsDataSource is a helper function to simplify the provider implementation.
+func NewIntegrationsDataSource() datasource.DataSource {
+	return &IntegrationsDataSource{}
+}
+
+// IntegrationsDataSource defines the data source implementation.
+type IntegrationsDataSource struct {
+	client *EunoClient
+}
+
+// IntegrationsDataSourceModel describes the data source data model.
+type IntegrationsDataSourceModel struct {
+	IntegrationType types.String              `tfsdk:"integration_type"`
+	Active          types.Bool                `tfsdk:"active"`
+	Health          types.String              `tfsdk:"health"`
+	LastRunStatus   types.String              `tfsdk:"last_run_status"`
+	NameRegex       types.String              `tfsdk:"name_regex"`
+	Integrations    []IntegrationSummaryModel `tfsdk:"integrations"`
+}
+
+// IntegrationSummaryModel describes an integration returned by the euno_integrations data source
+type IntegrationSummaryModel struct {
+	ID                      types.Int64    `tfsdk:"id"`
+	Name                    types.String   `tfsdk:"name"`
+	IntegrationType         types.String   `tfsdk:"integration_type"`
+	Active                  types.Bool     `tfsdk:"active"`
+	Schedule                *ScheduleModel `tfsdk:"schedule"`
+	Health                  types.String   `tfsdk:"health"`
+	LastRunStatus           types.String   `tfsdk:"last_run_status"`
+	LastRunError            types.String   `tfsdk:"last_run_error"`
+	LastCompletedRunEndTime types.String   `tfsdk:"last_completed_run_end_time"`
+}
+
+// integrationFilter selects integrations by their attributes. Empty fields match any integration.
+type integrationFilter struct {
+	IntegrationType string
+	Active          *bool
+	Health          string
+	LastRunStatus   string
+	NameRegex       *regexp.Regexp
+}
+
+// Metadata returns the data source type name.
+func (d *IntegrationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integrations"
+}
+
+// Schema defines the schema for the data source.
+func (d *IntegrationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Euno integrations of the account, optionally filtered",
+
+		Attributes: map[string]schema.Attribute{
+			"integration_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return integrations of this type, such as `snowflake`, `fivetran`, `hex` or `dbt_core`",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return active or inactive integrations",
+			},
+			"health": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return integrations with this health",
+			},
+			"last_run_status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return integrations whose last run has this status",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return integrations whose name matches this regular expression",
+			},
+			"integrations": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching integrations, ordered by ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the integration",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the integration",
+						},
+						"integration_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the integration",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the integration is active",
+						},
+						"schedule": dataSourceScheduleAttribute(),
+						"health": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The health of the integration",
+						},
+						"last_run_status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the last integration run",
+						},
+						"last_run_error": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The error of the last integration run",
+						},
+						"last_completed_run_end_time": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The end timestamp of the last completed run",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IntegrationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EunoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *EunoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that the name filter is a valid regular expression.
+func (d *IntegrationsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data IntegrationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.NameRegex.IsNull() || data.NameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(data.NameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Regular Expression",
+			fmt.Sprintf("name_regex %q is not a valid regular expression: %s", data.NameRegex.ValueString(), err),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *IntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IntegrationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := integrationFilter{
+		IntegrationType: data.IntegrationType.ValueString(),
+		Active:          data.Active.ValueBoolPointer(),
+		Health:          data.Health.ValueString(),
+		LastRunStatus:   data.LastRunStatus.ValueString(),
+	}
+	if !data.NameRegex.IsNull() {
+		nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		filter.NameRegex = nameRegex
+	}
+
+	integrations, err := d.client.ListIntegrations(ctx, filter.IntegrationType)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to list integrations", err)
+		return
+	}
+
+	// Map the matching integrations to the model
+	data.Integrations = []IntegrationSummaryModel{}
+	for _, integration := range filterIntegrations(integrations, filter) {
+		data.Integrations = append(data.Integrations, IntegrationSummaryModel{
+			ID:                      types.Int64Value(int64(integration.ID)),
+			Name:                    types.StringValue(integration.Name),
+			IntegrationType:         types.StringValue(integration.IntegrationType),
+			Active:                  types.BoolPointerValue(integration.Active),
+			Schedule:                convertScheduleFromAPI(integration.Schedule),
+			Health:                  types.StringPointerValue(integration.Health),
+			LastRunStatus:           types.StringPointerValue(integration.LastRunStatus),
+			LastRunError:            types.StringPointerValue(integration.LastRunError),
+			LastCompletedRunEndTime: types.StringPointerValue(integration.LastCompletedRunEndTime),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterIntegrations returns the integrations matching the filter, ordered by ID
+func filterIntegrations(integrations []IntegrationOut, filter integrationFilter) []IntegrationOut {
+	matches := []IntegrationOut{}
+	for _, integration := range integrations {
+		if filter.IntegrationType != "" && integration.IntegrationType != filter.IntegrationType {
+			continue
+		}
+		if filter.Active != nil && (integration.Active == nil || *integration.Active != *filter.Active) {
+			continue
+		}
+		if filter.Health != "" && (integration.Health == nil || *integration.Health != filter.Health) {
+			continue
+		}
+		if filter.LastRunStatus != "" && (integration.LastRunStatus == nil || *integration.LastRunStatus != filter.LastRunStatus) {
+			continue
+		}
+		if filter.NameRegex != nil && !filter.NameRegex.MatchString(integration.Name) {
+			continue
+		}
+		matches = append(matches, integration)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].ID < matches[j].ID
+	})
+
+	return matches
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestFilterIntegrations(t *testing.T) {
+	active := true
+	inactive := false
+	healthy := "healthy"
+	unhealthy := "unhealthy"
+	succeeded := "success"
+	failed := "failed"
+
+	integrations := []IntegrationOut{
+		{ID: 4, Name: "snowflake-sandbox", IntegrationType: "snowflake", Active: &inactive, Health: &unhealthy, LastRunStatus: &failed},
+		{ID: 1, Name: "snowflake-production", IntegrationType: "snowflake", Active: &active, Health: &healthy, LastRunStatus: &succeeded},
+		{ID: 2, Name: "fivetran", IntegrationType: "fivetran", Active: &active, Health: &unhealthy, LastRunStatus: &failed},
+		{ID: 3, Name: "dbt", IntegrationType: "dbt_core"},
+	}
+
+	tests := []struct {
+		name        string
+		filter      integrationFilter
+		expectedIDs []int
+	}{
+		{name: "no filter", expectedIDs: []int{1, 2, 3, 4}},
+		{name: "type", filter: integrationFilter{IntegrationType: "snowflake"}, expectedIDs: []int{1, 4}},
+		{name: "active", filter: integrationFilter{Active: &active}, expectedIDs: []int{1, 2}},
+		{name: "inactive", filter: integrationFilter{Active: &inactive}, expectedIDs: []int{4}},
+		{name: "health", filter: integrationFilter{Health: "unhealthy"}, expectedIDs: []int{2, 4}},
+		{name: "last run status", filter: integrationFilter{LastRunStatus: "success"}, expectedIDs: []int{1}},
+		{name: "name regex", filter: integrationFilter{NameRegex: regexp.MustCompile(`-(production|sandbox)$`)}, expectedIDs: []int{1, 4}},
+		{name: "combined", filter: integrationFilter{IntegrationType: "snowflake", Health: "unhealthy"}, expectedIDs: []int{4}},
+		{name: "no match", filter: integrationFilter{IntegrationType: "hex"}, expectedIDs: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := filterIntegrations(integrations, tt.filter)
+			if len(matches) != len(tt.expectedIDs) {
+				t.Fatalf("expected %d integrations, got %d", len(tt.expectedIDs), len(matches))
+			}
+			for i, id := range tt.expectedIDs {
+				if matches[i].ID != id {
+					t.Errorf("expected integration %d at position %d, got %d", id, i, matches[i].ID)
+				}
+			}
+		})
+	}
+}
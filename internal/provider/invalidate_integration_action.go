@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &InvalidateIntegrationAction{}
+var _ action.ActionWithConfigure = &InvalidateIntegrationAction{}
+var _ action.ActionWithValidateConfig = &InvalidateIntegrationAction{}
+
+// NewInvalidateIntegrationAction is a helper function to simplify the provider implementation.
+func NewInvalidateIntegrationAction() action.Action {
+	return &InvalidateIntegrationAction{}
+}
+
+// InvalidateIntegrationAction defines the action implementation.
+type InvalidateIntegrationAction struct {
+	client *EunoClient
+}
+
+// InvalidateIntegrationActionModel describes the action data model.
+type InvalidateIntegrationActionModel struct {
+	IntegrationID types.Int64 `tfsdk:"integration_id"`
+	BumpRevision  types.Bool  `tfsdk:"bump_revision"`
+	RevisionID    types.Int64 `tfsdk:"revision_id"`
+}
+
+// Metadata returns the action type name.
+func (a *InvalidateIntegrationAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invalidate_integration"
+}
+
+// Schema defines the schema for the action.
+func (a *InvalidateIntegrationAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invalidates the data collected by an Euno integration, so that stale resources are removed right away",
+
+		Attributes: map[string]schema.Attribute{
+			"integration_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the integration to invalidate",
+			},
+			"bump_revision": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to move the integration to the revision after its current `revision_id`, invalidating everything collected for earlier revisions. Conflicts with `revision_id`",
+			},
+			"revision_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The revision to move the integration to, invalidating everything collected for earlier revisions. Conflicts with `bump_revision`",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *InvalidateIntegrationAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EunoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *EunoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// ValidateConfig checks that at most one way of choosing the revision is set.
+func (a *InvalidateIntegrationAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data InvalidateIntegrationActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.BumpRevision.ValueBool() && !data.RevisionID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("revision_id"),
+			"Conflicting Revision",
+			"Only one of bump_revision or revision_id can be set.",
+		)
+	}
+
+	if !data.RevisionID.IsNull() && !data.RevisionID.IsUnknown() && data.RevisionID.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("revision_id"),
+			"Invalid Revision",
+			fmt.Sprintf("revision_id must be a positive number, got %d.", data.RevisionID.ValueInt64()),
+		)
+	}
+}
+
+// Invoke invalidates the collected data of the integration.
+func (a *InvalidateIntegrationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data InvalidateIntegrationActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integration, err := a.client.GetIntegration(ctx, int(data.IntegrationID.ValueInt64()))
+	if err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read integration %d", data.IntegrationID.ValueInt64()), err)
+		return
+	}
+
+	var invalidation InvalidationRequest
+	switch {
+	case !data.RevisionID.IsNull():
+		revisionID := int(data.RevisionID.ValueInt64())
+		invalidation.RevisionID = &revisionID
+	case data.BumpRevision.ValueBool():
+		revisionID := nextRevisionID(integration.InvalidationStrategy)
+		invalidation.RevisionID = &revisionID
+	}
+
+	if invalidation.RevisionID != nil {
+		sendProgress(resp, "Invalidating integration %q for revision %d", integration.Name, *invalidation.RevisionID)
+	} else {
+		sendProgress(resp, "Invalidating integration %q", integration.Name)
+	}
+
+	result, err := a.client.InvalidateIntegration(ctx, integration.ID, invalidation)
+	if err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to invalidate integration %q", integration.Name), err)
+		return
+	}
+
+	if result.RevisionID != nil {
+		sendProgress(resp, "Invalidated %d resources of integration %q, now at revision %d", result.InvalidatedResources, integration.Name, *result.RevisionID)
+	} else {
+		sendProgress(resp, "Invalidated %d resources of integration %q", result.InvalidatedResources, integration.Name)
+	}
+}
+
+// nextRevisionID returns the revision following the current revision of the invalidation strategy
+func nextRevisionID(strategy *InvalidationStrategy) int {
+	if strategy == nil || strategy.RevisionID == nil {
+		return 1
+	}
+
+	return *strategy.RevisionID + 1
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestInvalidateIntegrationActionInvoke(t *testing.T) {
+	ctx := context.Background()
+	currentRevision := 3
+
+	var received InvalidationRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/integrations/7"):
+			_ = json.NewEncoder(w).Encode(IntegrationOut{ID: 7, Name: "warehouse", InvalidationStrategy: &InvalidationStrategy{RevisionID: &currentRevision, TTLDays: 7}})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/integrations/7/invalidate"):
+			received = InvalidationRequest{}
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				t.Errorf("unable to decode request: %s", err)
+			}
+			_ = json.NewEncoder(w).Encode(InvalidationResult{InvalidatedResources: 12, RevisionID: received.RevisionID})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	a := &InvalidateIntegrationAction{client: NewEunoClient(server.URL, "key", 1)}
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		name             string
+		bumpRevision     interface{}
+		revisionID       interface{}
+		expectedRevision *int
+		expectedMessage  string
+	}{
+		{name: "current revision", expectedMessage: `Invalidated 12 resources of integration "warehouse"`},
+		{name: "bump revision", bumpRevision: true, expectedRevision: intPointer(4), expectedMessage: `Invalidated 12 resources of integration "warehouse", now at revision 4`},
+		{name: "explicit revision", revisionID: 10, expectedRevision: intPointer(10), expectedMessage: `Invalidated 12 resources of integration "warehouse", now at revision 10`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := action.InvokeRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+						"integration_id": tftypes.NewValue(tftypes.Number, 7),
+						"bump_revision":  tftypes.NewValue(tftypes.Bool, tt.bumpRevision),
+						"revision_id":    tftypes.NewValue(tftypes.Number, tt.revisionID),
+					}),
+				},
+			}
+
+			var messages []string
+			resp := action.InvokeResponse{
+				SendProgress: func(event action.InvokeProgressEvent) {
+					messages = append(messages, event.Message)
+				},
+			}
+
+			a.Invoke(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if (received.RevisionID == nil) != (tt.expectedRevision == nil) || (received.RevisionID != nil && *received.RevisionID != *tt.expectedRevision) {
+				t.Errorf("expected revision %v, got %v", tt.expectedRevision, received.RevisionID)
+			}
+			if len(messages) == 0 || messages[len(messages)-1] != tt.expectedMessage {
+				t.Errorf("expected final message %q, got %q", tt.expectedMessage, messages)
+			}
+		})
+	}
+}
+
+func TestNextRevisionID(t *testing.T) {
+	revision := 5
+
+	if got := nextRevisionID(nil); got != 1 {
+		t.Errorf("expected 1 without a strategy, got %d", got)
+	}
+	if got := nextRevisionID(&InvalidationStrategy{TTLDays: 7}); got != 1 {
+		t.Errorf("expected 1 without a revision, got %d", got)
+	}
+	if got := nextRevisionID(&InvalidationStrategy{RevisionID: &revision}); got != 6 {
+		t.Errorf("expected 6, got %d", got)
+	}
+}
+
+func intPointer(value int) *int {
+	return &value
+}
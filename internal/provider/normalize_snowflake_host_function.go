@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure NormalizeSnowflakeHostFunction satisfies the function interface.
+var _ function.Function = &NormalizeSnowflakeHostFunction{}
+
+// NormalizeSnowflakeHostFunction defines the normalize_snowflake_host function implementation.
+type NormalizeSnowflakeHostFunction struct{}
+
+// NewNormalizeSnowflakeHostFunction is a helper function to simplify the provider server and testing implementation.
+func NewNormalizeSnowflakeHostFunction() function.Function {
+	return &NormalizeSnowflakeHostFunction{}
+}
+
+// Metadata returns the function name.
+func (f *NormalizeSnowflakeHostFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_snowflake_host"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *NormalizeSnowflakeHostFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalize a Snowflake host",
+		MarkdownDescription: "Converts a Snowflake account locator, organization-account name, URL or privatelink host to the canonical host name that euno_snowflake_integration sends to Euno",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "host",
+				MarkdownDescription: "The Snowflake host in any supported form",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run normalizes the host.
+func (f *NormalizeSnowflakeHostFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &host))
+
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeSnowflakeHost(host)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, normalized))
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// uriAttributeTypes are the attribute types of the object returned by parse_uri
+var uriAttributeTypes = map[string]attr.Type{
+	"namespace": types.StringType,
+	"base":      types.StringType,
+	"path":      types.ListType{ElemType: types.StringType},
+}
+
+// Ensure ParseURIFunction satisfies the function interface.
+var _ function.Function = &ParseURIFunction{}
+
+// ParseURIFunction defines the parse_uri function implementation.
+type ParseURIFunction struct{}
+
+// NewParseURIFunction is a helper function to simplify the provider server and testing implementation.
+func NewParseURIFunction() function.Function {
+	return &ParseURIFunction{}
+}
+
+// Metadata returns the function name.
+func (f *ParseURIFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_uri"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *ParseURIFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse an Euno URI",
+		MarkdownDescription: "Splits an Euno URI into its namespace, such as `snowflake` or `dbt`, the base identifying the source, and the path of the asset within the source",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uri",
+				MarkdownDescription: "The Euno URI to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: uriAttributeTypes,
+		},
+	}
+}
+
+// Run parses the URI.
+func (f *ParseURIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var uri string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &uri))
+
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := parseEunoURI(uri)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	path := make([]attr.Value, len(parsed.Path))
+	for i, segment := range parsed.Path {
+		path[i] = types.StringValue(segment)
+	}
+
+	result := types.ObjectValueMust(uriAttributeTypes, map[string]attr.Value{
+		"namespace": types.StringValue(parsed.Namespace),
+		"base":      types.StringValue(parsed.Base),
+		"path":      types.ListValueMust(types.StringType, path),
+	})
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
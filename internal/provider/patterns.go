@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// validateNamePattern checks an object name pattern, which is either a regular expression
+// enclosed in slashes (/^PROD_.+$/) or a glob pattern (ANALYTICS_*). Plain names are globs
+// without wildcards.
+func validateNamePattern(pattern string) error {
+	if pattern == "" {
+		return errors.New("pattern must not be empty")
+	}
+
+	if len(pattern) >= 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		expr := pattern[1 : len(pattern)-1]
+		if expr == "" {
+			return errors.New("regular expression must not be empty")
+		}
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("invalid regular expression %s: %w", pattern, err)
+		}
+		return nil
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+	}
+
+	return nil
+}
+
+// isNamePattern returns whether the pattern matches more than a single literal name
+func isNamePattern(pattern string) bool {
+	return strings.HasPrefix(pattern, "/") || strings.ContainsAny(pattern, "*?[")
+}
@@ -0,0 +1,30 @@
+package provider
+
+import "testing"
+
+func TestValidateNamePattern(t *testing.T) {
+	tests := []struct {
+		pattern     string
+		expectError bool
+	}{
+		{pattern: "ANALYTICS"},
+		{pattern: "ANALYTICS_*"},
+		{pattern: "SANDBOX_?"},
+		{pattern: "[A-C]*"},
+		{pattern: "/^PROD_.+$/"},
+		{pattern: "/"},
+		{pattern: "", expectError: true},
+		{pattern: "//", expectError: true},
+		{pattern: "/(unclosed/", expectError: true},
+		{pattern: "[A-C", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			err := validateNamePattern(tt.pattern)
+			if (err != nil) != tt.expectError {
+				t.Errorf("expected error %t, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
@@ -3,7 +3,10 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,6 +15,9 @@ import (
 
 // Ensure EunoProvider satisfies various provider interfaces.
 var _ provider.Provider = &EunoProvider{}
+var _ provider.ProviderWithFunctions = &EunoProvider{}
+var _ provider.ProviderWithEphemeralResources = &EunoProvider{}
+var _ provider.ProviderWithActions = &EunoProvider{}
 
 // EunoProvider defines the provider implementation.
 type EunoProvider struct {
@@ -23,9 +29,10 @@ type EunoProvider struct {
 
 // EunoProviderModel describes the provider data model.
 type EunoProviderModel struct {
-	ServerURL types.String `tfsdk:"server_url"`
-	APIKey    types.String `tfsdk:"api_key"`
-	AccountID types.Int64  `tfsdk:"account_id"`
+	ServerURL           types.String `tfsdk:"server_url"`
+	APIKey              types.String `tfsdk:"api_key"`
+	AccountID           types.Int64  `tfsdk:"account_id"`
+	ValidateCredentials types.Bool   `tfsdk:"validate_credentials"`
 }
 
 // Metadata returns the provider type name.
@@ -51,6 +58,10 @@ func (p *EunoProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				MarkdownDescription: "The account ID for Euno integrations",
 				Required:            true,
 			},
+			"validate_credentials": schema.BoolAttribute{
+				MarkdownDescription: "Whether to verify the API key and access to the account when the provider is configured, instead of on the first API call. Defaults to `false`",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -70,8 +81,19 @@ func (p *EunoProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	// Example client configuration for data sources and resources
 	client := NewEunoClient(config.ServerURL.ValueString(), config.APIKey.ValueString(), int(config.AccountID.ValueInt64()))
+
+	if config.ValidateCredentials.ValueBool() {
+		resp.Diagnostics.Append(validateCredentials(ctx, client, config)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ActionData = client
 }
 
 // Resources defines the resources implemented in the provider.
@@ -81,13 +103,45 @@ func (p *EunoProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewSnowflakeIntegrationResource,
 		NewHexIntegrationResource,
 		NewDbtCoreIntegrationResource,
+		NewSnowflakeKeyPairResource,
+		NewDbtCoreArtifactsResource,
 	}
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *EunoProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// NewExampleDataSource,
+		NewIntegrationDataSource,
+		NewIntegrationsDataSource,
+		NewIntegrationRunsDataSource,
+		NewAccountDataSource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *EunoProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewIntegrationTriggerCredentialsEphemeralResource,
+		NewSnowflakeKeyPairEphemeralResource,
+	}
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *EunoProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewRunIntegrationAction,
+		NewInvalidateIntegrationAction,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *EunoProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewNormalizeSnowflakeHostFunction,
+		NewScheduleNextRunsFunction,
+		NewDailyScheduleFunction,
+		NewSnowflakeURIFunction,
+		NewParseURIFunction,
 	}
 }
 
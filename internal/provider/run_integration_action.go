@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultRunActionTimeout is the time allowed for the run to complete when timeout is not set
+const defaultRunActionTimeout = 30 * time.Minute
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &RunIntegrationAction{}
+var _ action.ActionWithConfigure = &RunIntegrationAction{}
+var _ action.ActionWithValidateConfig = &RunIntegrationAction{}
+
+// NewRunIntegrationAction is a helper function to simplify the provider implementation.
+func NewRunIntegrationAction() action.Action {
+	return &RunIntegrationAction{}
+}
+
+// RunIntegrationAction defines the action implementation.
+type RunIntegrationAction struct {
+	client *EunoClient
+}
+
+// RunIntegrationActionModel describes the action data model.
+type RunIntegrationActionModel struct {
+	IntegrationID     types.Int64  `tfsdk:"integration_id"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout           types.String `tfsdk:"timeout"`
+}
+
+// Metadata returns the action type name.
+func (a *RunIntegrationAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_integration"
+}
+
+// Schema defines the schema for the action.
+func (a *RunIntegrationAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Triggers a run of an Euno integration and optionally waits for it to complete",
+
+		Attributes: map[string]schema.Attribute{
+			"integration_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the integration to run",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to wait for the run to complete. A failed run is reported as an error (defaults to true)",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("How long to wait for the run to complete, as a duration such as `45m` (defaults to `%s`)", defaultRunActionTimeout),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *RunIntegrationAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*EunoClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *EunoClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// ValidateConfig checks that the timeout is a valid duration.
+func (a *RunIntegrationAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data RunIntegrationActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Timeout.IsNull() || data.Timeout.IsUnknown() {
+		return
+	}
+
+	if timeout, err := time.ParseDuration(data.Timeout.ValueString()); err != nil || timeout <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Timeout",
+			fmt.Sprintf("timeout must be a positive duration such as 45m or 1h30m, got %q.", data.Timeout.ValueString()),
+		)
+	}
+}
+
+// Invoke triggers the run and, when requested, waits for it to complete.
+func (a *RunIntegrationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RunIntegrationActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultRunActionTimeout
+	if !data.Timeout.IsNull() {
+		parsed, err := time.ParseDuration(data.Timeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", err.Error())
+			return
+		}
+		timeout = parsed
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Get the integration to know which run completed last
+	integration, err := a.client.GetIntegration(ctx, int(data.IntegrationID.ValueInt64()))
+	if err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to read integration %d", data.IntegrationID.ValueInt64()), err)
+		return
+	}
+
+	run, err := a.client.RunIntegration(ctx, integration.ID)
+	switch {
+	case errors.Is(err, ErrRunInProgress):
+		sendProgress(resp, "A run of integration %q is already in progress", integration.Name)
+	case err != nil:
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to run integration %q", integration.Name), err)
+		return
+	default:
+		sendProgress(resp, "Triggered run %d of integration %q", run.ID, integration.Name)
+	}
+
+	if !data.WaitForCompletion.IsNull() && !data.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	started := time.Now()
+	result, err := waitForIntegrationRun(ctx, a.client, integration.ID, integration.LastCompletedRunEndTime, func(*IntegrationOut) {
+		sendProgress(resp, "Integration %q is still running (%s elapsed)", integration.Name, time.Since(started).Round(time.Second))
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Unable to wait for integration %q to complete a run", integration.Name), err)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Integration Run Error", fmt.Sprintf("Integration %q did not complete a successful run: %s", integration.Name, err))
+		return
+	}
+
+	sendProgress(resp, "Integration %q completed a run with status %q", integration.Name, *result.LastRunStatus)
+}
+
+// sendProgress reports a progress message to Terraform while the action is invoked
+func sendProgress(resp *action.InvokeResponse, format string, args ...any) {
+	if resp.SendProgress == nil {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf(format, args...)})
+}
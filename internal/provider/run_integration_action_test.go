@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func invokeRunIntegrationAction(t *testing.T, a *RunIntegrationAction, wait *bool) (action.InvokeResponse, []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+
+	var waitValue tftypes.Value
+	if wait == nil {
+		waitValue = tftypes.NewValue(tftypes.Bool, nil)
+	} else {
+		waitValue = tftypes.NewValue(tftypes.Bool, *wait)
+	}
+
+	req := action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"integration_id":      tftypes.NewValue(tftypes.Number, 7),
+				"wait_for_completion": waitValue,
+				"timeout":             tftypes.NewValue(tftypes.String, "1m"),
+			}),
+		},
+	}
+
+	var messages []string
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+
+	a.Invoke(ctx, req, &resp)
+
+	return resp, messages
+}
+
+func TestRunIntegrationActionInvoke(t *testing.T) {
+	setRunPollInterval(t, time.Millisecond)
+
+	tests := []struct {
+		name             string
+		status           string
+		runError         string
+		expectedError    string
+		expectedMessages []string
+	}{
+		{
+			name:   "success",
+			status: "success",
+			expectedMessages: []string{
+				`Triggered run 1 of integration "test"`,
+				`Integration "test" completed a run with status "success"`,
+			},
+		},
+		{
+			name:             "failure",
+			status:           "failed",
+			runError:         "insufficient privileges",
+			expectedError:    `Integration "test" did not complete a successful run: run finished with status "failed": insufficient privileges`,
+			expectedMessages: []string{`Triggered run 1 of integration "test"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRunTestServer(t, tt.status, tt.runError)
+			defer server.Close()
+
+			resp, messages := invokeRunIntegrationAction(t, &RunIntegrationAction{client: NewEunoClient(server.URL, "key", 1)}, nil)
+
+			if tt.expectedError == "" && resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if tt.expectedError != "" && (resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Detail() != tt.expectedError) {
+				t.Fatalf("expected error %q, got %v", tt.expectedError, resp.Diagnostics)
+			}
+			if strings.Join(messages, "\n") != strings.Join(tt.expectedMessages, "\n") {
+				t.Errorf("expected progress %q, got %q", tt.expectedMessages, messages)
+			}
+		})
+	}
+}
+
+func TestRunIntegrationActionNoWait(t *testing.T) {
+	// The action returns after triggering the run, without reporting its outcome
+	server := newRunTestServer(t, "success", "")
+	defer server.Close()
+
+	wait := false
+	resp, messages := invokeRunIntegrationAction(t, &RunIntegrationAction{client: NewEunoClient(server.URL, "key", 1)}, &wait)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if len(messages) != 1 || messages[0] != `Triggered run 1 of integration "test"` {
+		t.Errorf("unexpected progress %q", messages)
+	}
+}
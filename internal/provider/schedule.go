@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	// Embed the time zone database, so that schedules can be evaluated on systems without one
+	_ "time/tzdata"
+)
+
+// scheduleWeekdays maps the day names accepted in repeat_on to weekdays
+var scheduleWeekdays = map[string]time.Weekday{
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+	"sunday":    time.Sunday,
+	"mon":       time.Monday,
+	"tue":       time.Tuesday,
+	"wed":       time.Wednesday,
+	"thu":       time.Thursday,
+	"fri":       time.Friday,
+	"sat":       time.Saturday,
+	"sun":       time.Sunday,
+}
+
+// maxScheduleRuns is the largest number of runs schedule_next_runs computes
+const maxScheduleRuns = 1000
+
+// parsedSchedule is a schedule with its fields parsed for evaluation
+type parsedSchedule struct {
+	location *time.Location
+	// days is nil when the schedule runs every day
+	days map[time.Weekday]bool
+	// clock is the time of day of the first run
+	clock time.Time
+	// period is zero when the schedule runs once a day
+	period time.Duration
+}
+
+// parseSchedule validates a schedule and parses it for evaluation
+func parseSchedule(schedule IntegrationSchedule) (*parsedSchedule, error) {
+	if schedule.TimeZone == "" {
+		return nil, errors.New("time_zone must not be empty")
+	}
+	location, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("time_zone %q is not a known IANA time zone", schedule.TimeZone)
+	}
+
+	parsed := &parsedSchedule{location: location}
+
+	if schedule.RepeatOn != nil {
+		if len(schedule.RepeatOn) == 0 {
+			return nil, errors.New("repeat_on must not be empty, omit it to run every day")
+		}
+		parsed.days = make(map[time.Weekday]bool, len(schedule.RepeatOn))
+		for _, day := range schedule.RepeatOn {
+			weekday, ok := scheduleWeekdays[strings.ToLower(day)]
+			if !ok {
+				return nil, fmt.Errorf("repeat_on day %q is not a day of the week", day)
+			}
+			parsed.days[weekday] = true
+		}
+	}
+
+	if schedule.RepeatTime != "" {
+		repeatTime, err := normalizeRepeatTime(schedule.RepeatTime)
+		if err != nil {
+			return nil, err
+		}
+		parsed.clock, _ = time.Parse(time.TimeOnly, repeatTime)
+	}
+
+	if schedule.RepeatPeriod != nil {
+		if *schedule.RepeatPeriod < 1 || *schedule.RepeatPeriod > 24 {
+			return nil, fmt.Errorf("repeat_period must be between 1 and 24 hours, got %d", *schedule.RepeatPeriod)
+		}
+		parsed.period = time.Duration(*schedule.RepeatPeriod) * time.Hour
+	}
+
+	return parsed, nil
+}
+
+// normalizeRepeatTime converts a time of day given as HH:MM or HH:MM:SS to the HH:MM:SS form
+func normalizeRepeatTime(value string) (string, error) {
+	for _, layout := range []string{time.TimeOnly, "15:04"} {
+		if clock, err := time.Parse(layout, value); err == nil {
+			return clock.Format(time.TimeOnly), nil
+		}
+	}
+
+	return "", fmt.Errorf("repeat_time %q must be a time of day in HH:MM:SS format", value)
+}
+
+// normalizeScheduleDays validates day names and converts them to lower case full names,
+// in the order given, without duplicates
+func normalizeScheduleDays(days []string) ([]string, error) {
+	normalized := make([]string, 0, len(days))
+	seen := make(map[time.Weekday]bool, len(days))
+	for _, day := range days {
+		weekday, ok := scheduleWeekdays[strings.ToLower(day)]
+		if !ok {
+			return nil, fmt.Errorf("day %q is not a day of the week", day)
+		}
+		if seen[weekday] {
+			continue
+		}
+		seen[weekday] = true
+		normalized = append(normalized, strings.ToLower(weekday.String()))
+	}
+
+	return normalized, nil
+}
+
+// nextRuns returns the first count run times of the schedule after from. Each scheduled
+// day has a run at the repeat time, followed by a run every period until the end of the day.
+func (s *parsedSchedule) nextRuns(from time.Time, count int) []time.Time {
+	from = from.In(s.location)
+	runs := make([]time.Time, 0, count)
+
+	// Every week has at least one scheduled day, so the runs are found within count weeks
+	for i := 0; len(runs) < count && i <= 7*(count+1); i++ {
+		year, month, day := from.AddDate(0, 0, i).Date()
+		if s.days != nil && !s.days[time.Date(year, month, day, 0, 0, 0, 0, s.location).Weekday()] {
+			continue
+		}
+
+		first := time.Date(year, month, day, s.clock.Hour(), s.clock.Minute(), s.clock.Second(), 0, s.location)
+		nextMidnight := time.Date(year, month, day+1, 0, 0, 0, 0, s.location)
+		for run := first; run.Before(nextMidnight) && len(runs) < count; run = run.Add(s.period) {
+			if run.After(from) {
+				runs = append(runs, run)
+			}
+			if s.period == 0 {
+				break
+			}
+		}
+	}
+
+	return runs
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure ScheduleNextRunsFunction satisfies the function interface.
+var _ function.Function = &ScheduleNextRunsFunction{}
+
+// ScheduleNextRunsFunction defines the schedule_next_runs function implementation.
+type ScheduleNextRunsFunction struct{}
+
+// NewScheduleNextRunsFunction is a helper function to simplify the provider server and testing implementation.
+func NewScheduleNextRunsFunction() function.Function {
+	return &ScheduleNextRunsFunction{}
+}
+
+// Metadata returns the function name.
+func (f *ScheduleNextRunsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_next_runs"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *ScheduleNextRunsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Compute the next runs of a schedule",
+		MarkdownDescription: "Computes the upcoming run times of an integration schedule, as RFC 3339 timestamps in the schedule's time zone",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "schedule",
+				MarkdownDescription: "An object with the schedule attributes `time_zone`, and optionally `repeat_on`, `repeat_time` and `repeat_period`",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: fmt.Sprintf("The number of runs to compute, between 1 and %d", maxScheduleRuns),
+			},
+			function.StringParameter{
+				Name:                "from",
+				MarkdownDescription: "An RFC 3339 timestamp to compute the runs after, usually `plantimestamp()`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run computes the next runs of the schedule.
+func (f *ScheduleNextRunsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value types.Dynamic
+	var count int64
+	var from string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value, &count, &from))
+
+	if resp.Error != nil {
+		return
+	}
+
+	schedule, err := scheduleFromValue(value.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	parsed, err := parseSchedule(schedule)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if count < 1 || count > maxScheduleRuns {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("count must be between 1 and %d, got %d", maxScheduleRuns, count))
+		return
+	}
+
+	start, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("from must be an RFC 3339 timestamp, got %q", from))
+		return
+	}
+
+	runs := parsed.nextRuns(start, int(count))
+	result := make([]string, len(runs))
+	for i, run := range runs {
+		result[i] = run.Format(time.RFC3339)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// scheduleFromValue converts a schedule object given to a function to API format. Attributes
+// may be omitted or null, so that both partial objects and the schedule of a resource are accepted.
+func scheduleFromValue(value attr.Value) (IntegrationSchedule, error) {
+	var schedule IntegrationSchedule
+
+	object, ok := value.(types.Object)
+	if !ok || object.IsNull() || object.IsUnknown() {
+		return schedule, errors.New("schedule must be an object with a time_zone attribute")
+	}
+
+	for name, attribute := range object.Attributes() {
+		if attribute.IsNull() {
+			continue
+		}
+
+		var err error
+		switch name {
+		case "time_zone":
+			schedule.TimeZone, err = stringFromValue(name, attribute)
+		case "repeat_time":
+			schedule.RepeatTime, err = stringFromValue(name, attribute)
+		case "repeat_on":
+			schedule.RepeatOn, err = stringsFromValue(name, attribute)
+		case "repeat_period":
+			var period int
+			period, err = intFromValue(name, attribute)
+			schedule.RepeatPeriod = &period
+		default:
+			err = fmt.Errorf("unsupported schedule attribute %q", name)
+		}
+		if err != nil {
+			return schedule, err
+		}
+	}
+
+	return schedule, nil
+}
+
+// stringFromValue returns the string held by a schedule attribute
+func stringFromValue(name string, value attr.Value) (string, error) {
+	str, ok := value.(types.String)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string", name)
+	}
+
+	return str.ValueString(), nil
+}
+
+// stringsFromValue returns the strings held by a list, set or tuple schedule attribute
+func stringsFromValue(name string, value attr.Value) ([]string, error) {
+	var elements []attr.Value
+	switch collection := value.(type) {
+	case types.List:
+		elements = collection.Elements()
+	case types.Set:
+		elements = collection.Elements()
+	case types.Tuple:
+		elements = collection.Elements()
+	default:
+		return nil, fmt.Errorf("%s must be a list of strings", name)
+	}
+
+	values := make([]string, len(elements))
+	for i, element := range elements {
+		str, ok := element.(types.String)
+		if !ok || str.IsNull() {
+			return nil, fmt.Errorf("%s must be a list of strings", name)
+		}
+		values[i] = str.ValueString()
+	}
+
+	return values, nil
+}
+
+// intFromValue returns the whole number held by a schedule attribute
+func intFromValue(name string, value attr.Value) (int, error) {
+	switch number := value.(type) {
+	case types.Int64:
+		return int(number.ValueInt64()), nil
+	case types.Number:
+		if n, accuracy := number.ValueBigFloat().Int64(); accuracy == big.Exact {
+			return int(n), nil
+		}
+	}
+
+	return 0, fmt.Errorf("%s must be a whole number", name)
+}
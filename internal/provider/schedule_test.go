@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestScheduleNextRuns(t *testing.T) {
+	period := func(hours int) *int { return &hours }
+
+	tests := []struct {
+		name     string
+		schedule IntegrationSchedule
+		from     string
+		count    int
+		expected []string
+	}{
+		{
+			name:     "daily",
+			schedule: IntegrationSchedule{TimeZone: "UTC", RepeatTime: "06:00:00"},
+			from:     "2026-03-02T10:00:00Z",
+			count:    3,
+			expected: []string{"2026-03-03T06:00:00Z", "2026-03-04T06:00:00Z", "2026-03-05T06:00:00Z"},
+		},
+		{
+			name:     "later today",
+			schedule: IntegrationSchedule{TimeZone: "UTC", RepeatTime: "06:00:00"},
+			from:     "2026-03-02T05:00:00Z",
+			count:    1,
+			expected: []string{"2026-03-02T06:00:00Z"},
+		},
+		{
+			name:     "run at from is excluded",
+			schedule: IntegrationSchedule{TimeZone: "UTC", RepeatTime: "06:00:00"},
+			from:     "2026-03-02T06:00:00Z",
+			count:    1,
+			expected: []string{"2026-03-03T06:00:00Z"},
+		},
+		{
+			name:     "weekdays",
+			schedule: IntegrationSchedule{TimeZone: "UTC", RepeatOn: []string{"Mon", "wednesday", "FRI"}, RepeatTime: "10:00:00"},
+			from:     "2026-03-03T00:00:00Z", // Tuesday
+			count:    4,
+			expected: []string{"2026-03-04T10:00:00Z", "2026-03-06T10:00:00Z", "2026-03-09T10:00:00Z", "2026-03-11T10:00:00Z"},
+		},
+		{
+			name:     "time zone",
+			schedule: IntegrationSchedule{TimeZone: "America/Los_Angeles", RepeatTime: "09:30:00"},
+			from:     "2026-01-15T00:00:00Z",
+			count:    2,
+			expected: []string{"2026-01-15T09:30:00-08:00", "2026-01-16T09:30:00-08:00"},
+		},
+		{
+			name:     "daylight saving time change",
+			schedule: IntegrationSchedule{TimeZone: "Europe/Berlin", RepeatTime: "06:00:00"},
+			from:     "2026-03-28T12:00:00Z",
+			count:    2,
+			expected: []string{"2026-03-29T06:00:00+02:00", "2026-03-30T06:00:00+02:00"},
+		},
+		{
+			name:     "period",
+			schedule: IntegrationSchedule{TimeZone: "UTC", RepeatTime: "02:00:00", RepeatPeriod: period(8)},
+			from:     "2026-03-02T03:00:00Z",
+			count:    4,
+			expected: []string{"2026-03-02T10:00:00Z", "2026-03-02T18:00:00Z", "2026-03-03T02:00:00Z", "2026-03-03T10:00:00Z"},
+		},
+		{
+			name:     "period without repeat time",
+			schedule: IntegrationSchedule{TimeZone: "UTC", RepeatPeriod: period(12)},
+			from:     "2026-03-02T01:00:00Z",
+			count:    3,
+			expected: []string{"2026-03-02T12:00:00Z", "2026-03-03T00:00:00Z", "2026-03-03T12:00:00Z"},
+		},
+		{
+			name:     "period on some days",
+			schedule: IntegrationSchedule{TimeZone: "UTC", RepeatOn: []string{"saturday"}, RepeatTime: "12:00:00", RepeatPeriod: period(6)},
+			from:     "2026-03-02T00:00:00Z",
+			count:    3,
+			expected: []string{"2026-03-07T12:00:00Z", "2026-03-07T18:00:00Z", "2026-03-14T12:00:00Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := parseSchedule(tt.schedule)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			from, _ := time.Parse(time.RFC3339, tt.from)
+
+			runs := parsed.nextRuns(from, tt.count)
+			got := make([]string, len(runs))
+			for i, run := range runs {
+				got[i] = run.Format(time.RFC3339)
+			}
+			if strings.Join(got, " ") != strings.Join(tt.expected, " ") {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseScheduleErrors(t *testing.T) {
+	zero := 0
+
+	tests := []struct {
+		name        string
+		schedule    IntegrationSchedule
+		expectedErr string
+	}{
+		{name: "missing time zone", schedule: IntegrationSchedule{}, expectedErr: "time_zone must not be empty"},
+		{name: "unknown time zone", schedule: IntegrationSchedule{TimeZone: "Mars/Olympus"}, expectedErr: `time_zone "Mars/Olympus" is not a known IANA time zone`},
+		{name: "empty days", schedule: IntegrationSchedule{TimeZone: "UTC", RepeatOn: []string{}}, expectedErr: "repeat_on must not be empty, omit it to run every day"},
+		{name: "unknown day", schedule: IntegrationSchedule{TimeZone: "UTC", RepeatOn: []string{"funday"}}, expectedErr: `repeat_on day "funday" is not a day of the week`},
+		{name: "invalid time", schedule: IntegrationSchedule{TimeZone: "UTC", RepeatTime: "25:00"}, expectedErr: `repeat_time "25:00" must be a time of day in HH:MM:SS format`},
+		{name: "invalid period", schedule: IntegrationSchedule{TimeZone: "UTC", RepeatPeriod: &zero}, expectedErr: "repeat_period must be between 1 and 24 hours, got 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseSchedule(tt.schedule); err == nil || err.Error() != tt.expectedErr {
+				t.Errorf("expected error %q, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestScheduleNextRunsFunction(t *testing.T) {
+	ctx := context.Background()
+
+	// A partial object literal, as written in configuration
+	schedule := types.ObjectValueMust(
+		map[string]attr.Type{
+			"time_zone":   types.StringType,
+			"repeat_on":   types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+			"repeat_time": types.StringType,
+		},
+		map[string]attr.Value{
+			"time_zone":   types.StringValue("UTC"),
+			"repeat_on":   types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("monday")}),
+			"repeat_time": types.StringValue("06:00:00"),
+		},
+	)
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.DynamicValue(schedule),
+			types.Int64Value(2),
+			types.StringValue("2026-03-02T10:00:00Z"),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+
+	NewScheduleNextRunsFunction().Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	expected := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("2026-03-09T06:00:00Z"),
+		types.StringValue("2026-03-16T06:00:00Z"),
+	})
+	if !resp.Result.Value().Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, resp.Result.Value())
+	}
+
+	// The runs are computed from a given time only, so that plan and apply agree
+	req.Arguments = function.NewArgumentsData([]attr.Value{types.DynamicValue(schedule), types.Int64Value(2), types.StringValue("now")})
+	resp = function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+
+	NewScheduleNextRunsFunction().Run(ctx, req, &resp)
+
+	if resp.Error == nil || resp.Error.Text != `from must be an RFC 3339 timestamp, got "now"` {
+		t.Errorf("expected invalid from error, got %v", resp.Error)
+	}
+}
+
+func TestDailyScheduleFunction(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		timeZone    string
+		repeatTime  string
+		repeatOn    []string
+		expected    map[string]attr.Value
+		expectedErr string
+	}{
+		{
+			name:       "every day",
+			timeZone:   "Europe/Berlin",
+			repeatTime: "06:30",
+			expected: map[string]attr.Value{
+				"time_zone":     types.StringValue("Europe/Berlin"),
+				"repeat_on":     types.ListNull(types.StringType),
+				"repeat_time":   types.StringValue("06:30:00"),
+				"repeat_period": types.Int64Null(),
+			},
+		},
+		{
+			name:       "some days",
+			timeZone:   "UTC",
+			repeatTime: "22:15:30",
+			repeatOn:   []string{"Mon", "friday", "monday"},
+			expected: map[string]attr.Value{
+				"time_zone":     types.StringValue("UTC"),
+				"repeat_on":     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("monday"), types.StringValue("friday")}),
+				"repeat_time":   types.StringValue("22:15:30"),
+				"repeat_period": types.Int64Null(),
+			},
+		},
+		{name: "invalid time", timeZone: "UTC", repeatTime: "6am", expectedErr: `repeat_time "6am" must be a time of day in HH:MM:SS format`},
+		{name: "invalid day", timeZone: "UTC", repeatTime: "06:00", repeatOn: []string{"someday"}, expectedErr: `day "someday" is not a day of the week`},
+		{name: "invalid time zone", timeZone: "Nowhere", repeatTime: "06:00", expectedErr: `time_zone "Nowhere" is not a known IANA time zone`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days := make([]attr.Value, len(tt.repeatOn))
+			dayTypes := make([]attr.Type, len(tt.repeatOn))
+			for i, day := range tt.repeatOn {
+				days[i] = types.StringValue(day)
+				dayTypes[i] = types.StringType
+			}
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.timeZone),
+					types.StringValue(tt.repeatTime),
+					types.TupleValueMust(dayTypes, days),
+				}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(scheduleAttributeTypes))}
+
+			NewDailyScheduleFunction().Run(ctx, req, &resp)
+
+			if tt.expectedErr != "" {
+				if resp.Error == nil || resp.Error.Text != tt.expectedErr {
+					t.Fatalf("expected error %q, got %v", tt.expectedErr, resp.Error)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			expected := types.ObjectValueMust(scheduleAttributeTypes, tt.expected)
+			if !resp.Result.Value().Equal(expected) {
+				t.Errorf("expected %s, got %s", expected, resp.Result.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// snowflakeAccountUsageFeatures returns whether the configuration enables any feature that
+// reads the SNOWFLAKE.ACCOUNT_USAGE views, defaulting unset flags like the Euno API does
+func snowflakeAccountUsageFeatures(config SnowflakeConfigurationModel) bool {
+	return boolValueOrDefault(config.ExtractDailyUsage, true) ||
+		boolValueOrDefault(config.ExtractDailyDMLSummary, true) ||
+		boolValueOrDefault(config.ExtractTableauUsage, true) ||
+		boolValueOrDefault(config.ExtractLineageFromQueryHistory, true) ||
+		boolValueOrDefault(config.ExtractMaterializedViewsRefreshHistory, false) ||
+		boolValueOrDefault(config.ExtractHexUsage, false) ||
+		boolValueOrDefault(config.ExtractHexLineage, false) ||
+		boolValueOrDefault(config.UseSnowflakeDatabase, false)
+}
+
+// renderSnowflakeGrants renders the GRANT statements the configured role needs for the enabled
+// features. The result is unknown while any input is unknown, and null without a role.
+func renderSnowflakeGrants(config SnowflakeConfigurationModel) types.String {
+	inputs := []attr.Value{
+		config.User, config.Role, config.Warehouse, config.Database, config.Databases, config.TableToUseForQueryHistory,
+		config.ExtractViews, config.ExtractTables, config.ExtractTableauUsage, config.ExtractDailyUsage,
+		config.ExtractDailyDMLSummary, config.ExtractMaterializedViewsRefreshHistory, config.ExtractHexUsage,
+		config.ExtractHexLineage, config.ObserveWarehouses, config.UseSnowflakeDatabase,
+		config.ExtractLineageFromQueryHistory, config.ObserveInboundShares,
+	}
+	inputs = append(inputs, config.Databases.Elements()...)
+	for _, input := range inputs {
+		if input.IsUnknown() {
+			return types.StringUnknown()
+		}
+	}
+
+	if config.Role.IsNull() {
+		return types.StringNull()
+	}
+
+	role := snowflakeIdentifier(config.Role.ValueString())
+	var statements []string
+	grant := func(format string, args ...interface{}) {
+		statements = append(statements, fmt.Sprintf(format, args...)+" TO ROLE "+role+";")
+	}
+
+	statements = append(statements, fmt.Sprintf("GRANT ROLE %s TO USER %s;", role, snowflakeIdentifier(config.User.ValueString())))
+
+	if !config.Warehouse.IsNull() {
+		warehouse := snowflakeIdentifier(config.Warehouse.ValueString())
+		grant("GRANT USAGE ON WAREHOUSE %s", warehouse)
+		if boolValueOrDefault(config.ObserveWarehouses, false) {
+			grant("GRANT MONITOR ON WAREHOUSE %s", warehouse)
+		}
+	}
+
+	if boolValueOrDefault(config.ObserveWarehouses, false) {
+		grant("GRANT MONITOR USAGE ON ACCOUNT")
+	}
+
+	extractTables := boolValueOrDefault(config.ExtractTables, true)
+	extractViews := boolValueOrDefault(config.ExtractViews, true)
+
+	var databases []string
+	if !config.Database.IsNull() {
+		databases = append(databases, config.Database.ValueString())
+	}
+	databases = append(databases, convertStringListToAPI(config.Databases)...)
+
+	if len(databases) == 0 && (extractTables || extractViews) {
+		statements = append(statements, "-- Set configuration.databases to render the grants on the observed databases")
+	}
+
+	for _, name := range databases {
+		// Grants cannot target a pattern, each matching database must be granted individually
+		if isNamePattern(name) {
+			statements = append(statements, fmt.Sprintf("-- Grant USAGE and REFERENCES on each database matching %s individually", name))
+			continue
+		}
+
+		database := snowflakeIdentifier(name)
+		grant("GRANT USAGE ON DATABASE %s", database)
+		grant("GRANT USAGE ON ALL SCHEMAS IN DATABASE %s", database)
+		grant("GRANT USAGE ON FUTURE SCHEMAS IN DATABASE %s", database)
+		if extractTables {
+			grant("GRANT REFERENCES ON ALL TABLES IN DATABASE %s", database)
+			grant("GRANT REFERENCES ON FUTURE TABLES IN DATABASE %s", database)
+		}
+		if extractViews {
+			grant("GRANT REFERENCES ON ALL VIEWS IN DATABASE %s", database)
+			grant("GRANT REFERENCES ON FUTURE VIEWS IN DATABASE %s", database)
+		}
+	}
+
+	if snowflakeAccountUsageFeatures(config) {
+		grant("GRANT IMPORTED PRIVILEGES ON DATABASE SNOWFLAKE")
+	}
+
+	// A custom query history table outside the SNOWFLAKE database needs its own grant
+	if table := config.TableToUseForQueryHistory.ValueString(); table != "" && !strings.HasPrefix(strings.ToUpper(table), "SNOWFLAKE.") {
+		grant("GRANT SELECT ON TABLE %s", table)
+	}
+
+	// The databases created from inbound shares are not part of the configuration, and IMPORT SHARE
+	// would let the role create them rather than read them
+	if boolValueOrDefault(config.ObserveInboundShares, true) {
+		statements = append(statements, fmt.Sprintf("-- Run GRANT IMPORTED PRIVILEGES ON DATABASE <share_db> TO ROLE %s; for each database created from an inbound share", role))
+	}
+
+	return types.StringValue(strings.Join(statements, "\n") + "\n")
+}
+
+// boolValueOrDefault returns the value of a known boolean, or the default when it is null
+func boolValueOrDefault(v types.Bool, defaultValue bool) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return defaultValue
+	}
+
+	return v.ValueBool()
+}
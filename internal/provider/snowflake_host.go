@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// snowflakeHostDomains are the domains Snowflake account hosts are served from
+var snowflakeHostDomains = []string{".snowflakecomputing.com", ".snowflakecomputing.cn"}
+
+// snowflakeSnowsightHost is the host of Snowsight URLs, which contain the organization and account in the path
+const snowflakeSnowsightHost = "app.snowflake.com"
+
+// snowflakeHostPattern matches the characters allowed in a Snowflake account host
+var snowflakeHostPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9_.-]*[a-z0-9])?$`)
+
+// normalizeSnowflakeHost converts the forms a Snowflake account is commonly given in to the
+// canonical host name. Account locators (xy12345.us-east-2.aws), organization-account names
+// (myorg-myaccount), privatelink hosts, full URLs and Snowsight URLs are supported. The
+// result is lower case, without scheme, port or path, and ends with the Snowflake domain.
+func normalizeSnowflakeHost(raw string) (string, error) {
+	host := strings.ToLower(strings.TrimSpace(raw))
+	if host == "" {
+		return "", errors.New("host must not be empty")
+	}
+
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+
+	u, err := url.Parse(host)
+	if err != nil || u.Hostname() == "" {
+		return "", fmt.Errorf("%q is not a valid Snowflake host", raw)
+	}
+	host = strings.TrimSuffix(u.Hostname(), ".")
+
+	// Snowsight URLs have the form app.snowflake.com/<organization>/<account>/...
+	if host == snowflakeSnowsightHost {
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(segments) < 2 || segments[0] == "" || segments[1] == "" {
+			return "", fmt.Errorf("%q is a Snowsight URL without organization and account", raw)
+		}
+		host = segments[0] + "-" + segments[1]
+	}
+
+	if !snowflakeHostPattern.MatchString(host) {
+		return "", fmt.Errorf("%q is not a valid Snowflake host", raw)
+	}
+
+	for _, domain := range snowflakeHostDomains {
+		if host == strings.TrimPrefix(domain, ".") {
+			return "", fmt.Errorf("%q does not identify a Snowflake account", raw)
+		}
+		if strings.HasSuffix(host, domain) {
+			return host, nil
+		}
+	}
+
+	return host + snowflakeHostDomains[0], nil
+}
+
+// snowflakeHostFromAPI returns the host to store in state for the host reported by Euno. The
+// configured form is kept when it normalizes to the reported host, so that it does not show
+// a difference on every plan.
+func snowflakeHostFromAPI(current types.String, apiHost string) types.String {
+	if !current.IsNull() && !current.IsUnknown() {
+		if normalized, err := normalizeSnowflakeHost(current.ValueString()); err == nil && normalized == apiHost {
+			return current
+		}
+	}
+
+	return types.StringValue(apiHost)
+}
+
+// snowflakeHostToAPI returns the canonical form of the host, or the host as configured when it
+// cannot be normalized. Invalid hosts are reported by ValidateConfig.
+func snowflakeHostToAPI(host string) string {
+	if normalized, err := normalizeSnowflakeHost(host); err == nil {
+		return normalized
+	}
+
+	return host
+}
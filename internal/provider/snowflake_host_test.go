@@ -0,0 +1,39 @@
+package provider
+
+import "testing"
+
+func TestNormalizeSnowflakeHost(t *testing.T) {
+	tests := []struct {
+		host        string
+		expected    string
+		expectError bool
+	}{
+		{host: "myorg-myaccount", expected: "myorg-myaccount.snowflakecomputing.com"},
+		{host: "MyOrg-MyAccount.snowflakecomputing.com", expected: "myorg-myaccount.snowflakecomputing.com"},
+		{host: "https://myorg-myaccount.snowflakecomputing.com/", expected: "myorg-myaccount.snowflakecomputing.com"},
+		{host: " https://myorg-myaccount.snowflakecomputing.com:443/console#/ ", expected: "myorg-myaccount.snowflakecomputing.com"},
+		{host: "myorg-myaccount.snowflakecomputing.com.", expected: "myorg-myaccount.snowflakecomputing.com"},
+		{host: "xy12345.us-east-2.aws", expected: "xy12345.us-east-2.aws.snowflakecomputing.com"},
+		{host: "xy12345", expected: "xy12345.snowflakecomputing.com"},
+		{host: "myorg-myaccount.privatelink.snowflakecomputing.com", expected: "myorg-myaccount.privatelink.snowflakecomputing.com"},
+		{host: "xy12345.cn-north-1.snowflakecomputing.cn", expected: "xy12345.cn-north-1.snowflakecomputing.cn"},
+		{host: "https://app.snowflake.com/myorg/myaccount/worksheets", expected: "myorg-myaccount.snowflakecomputing.com"},
+		{host: "", expectError: true},
+		{host: "snowflakecomputing.com", expectError: true},
+		{host: "https://app.snowflake.com/", expectError: true},
+		{host: "my account", expectError: true},
+		{host: "-myaccount", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			actual, err := normalizeSnowflakeHost(tt.host)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error %t, got %v", tt.expectError, err)
+			}
+			if actual != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}
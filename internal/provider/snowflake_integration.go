@@ -3,7 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -12,22 +16,31 @@ import (
 // Ensure SnowflakeIntegrationResource satisfies various resource interfaces.
 var _ resource.Resource = &SnowflakeIntegrationResource{}
 var _ resource.ResourceWithImportState = &SnowflakeIntegrationResource{}
+var _ resource.ResourceWithValidateConfig = &SnowflakeIntegrationResource{}
+var _ resource.ResourceWithModifyPlan = &SnowflakeIntegrationResource{}
 
 // SnowflakeIntegrationResourceModel describes the Snowflake integration resource data model.
 type SnowflakeIntegrationResourceModel struct {
 	BaseIntegrationResourceModel
-	Configuration SnowflakeConfigurationModel `tfsdk:"configuration"`
+	RequiredGrantsSQL types.String                `tfsdk:"required_grants_sql"`
+	Configuration     SnowflakeConfigurationModel `tfsdk:"configuration"`
 }
 
 // SnowflakeConfigurationModel describes the Snowflake-specific configuration
 type SnowflakeConfigurationModel struct {
 	Host                                      types.String  `tfsdk:"host"`
 	User                                      types.String  `tfsdk:"user"`
+	AuthenticationMethod                      types.String  `tfsdk:"authentication_method"`
 	Password                                  types.String  `tfsdk:"password"`
 	PrivateKey                                types.String  `tfsdk:"private_key"`
+	PrivateKeyPassphrase                      types.String  `tfsdk:"private_key_passphrase"`
+	PublicKeyFingerprint                      types.String  `tfsdk:"public_key_fingerprint"`
 	Role                                      types.String  `tfsdk:"role"`
 	Warehouse                                 types.String  `tfsdk:"warehouse"`
 	Database                                  types.String  `tfsdk:"database"`
+	Databases                                 types.List    `tfsdk:"databases"`
+	IncludeSchemas                            types.List    `tfsdk:"include_schemas"`
+	ExcludeSchemas                            types.List    `tfsdk:"exclude_schemas"`
 	TableToUseForQueryHistory                 types.String  `tfsdk:"table_to_use_for_query_history"`
 	AdditionalWhereClauseForQueryHistoryQuery types.String  `tfsdk:"additional_where_clause_for_query_history_query"`
 	OverridePlatformURI                       types.String  `tfsdk:"override_platform_uri"`
@@ -50,6 +63,16 @@ type SnowflakeConfigurationModel struct {
 	ObserveInboundShares                      types.Bool    `tfsdk:"observe_inbound_shares"`
 }
 
+// Snowflake authentication methods
+const (
+	snowflakeAuthKeyPair  = "key_pair"
+	snowflakeAuthPassword = "password"
+	snowflakeAuthOAuth    = "oauth"
+)
+
+// snowflakeAuthenticationMethods lists the supported Snowflake authentication methods
+var snowflakeAuthenticationMethods = []string{snowflakeAuthKeyPair, snowflakeAuthPassword, snowflakeAuthOAuth}
+
 // SnowflakeIntegrationResource defines the Snowflake integration resource implementation.
 type SnowflakeIntegrationResource struct {
 	BaseIntegrationResource
@@ -57,7 +80,9 @@ type SnowflakeIntegrationResource struct {
 
 // NewSnowflakeIntegrationResource is a helper function to simplify the provider server and testing implementation.
 func NewSnowflakeIntegrationResource() resource.Resource {
-	return &SnowflakeIntegrationResource{}
+	return &SnowflakeIntegrationResource{
+		BaseIntegrationResource: BaseIntegrationResource{integrationType: "snowflake"},
+	}
 }
 
 // Metadata returns the resource type name.
@@ -70,8 +95,13 @@ func (r *SnowflakeIntegrationResource) Schema(ctx context.Context, req resource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Euno Snowflake Integration resource",
 
-		Attributes: getCommonAttributes(),
-		Blocks:     getCommonBlocks(),
+		Attributes: getCommonAttributesForPull(),
+		Blocks:     getCommonBlocks(ctx),
+	}
+
+	resp.Schema.Attributes["required_grants_sql"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The GRANT statements the configured role and user need in Snowflake for the enabled features. Null when configuration.role is not set",
 	}
 
 	// Add Snowflake-specific configuration block
@@ -80,12 +110,17 @@ func (r *SnowflakeIntegrationResource) Schema(ctx context.Context, req resource.
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Snowflake host",
+				MarkdownDescription: "Snowflake host. Account locators, organization-account names, URLs and privatelink hosts are accepted and sent to Euno in canonical form (e.g. `myorg-myaccount.snowflakecomputing.com`)",
 			},
 			"user": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "Snowflake user",
 			},
+			"authentication_method": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Snowflake authentication method, one of `key_pair`, `password` or `oauth`. Inferred from the configured credentials when not set. With `oauth`, credentials are completed in Euno using pending_credentials_lookup_key",
+			},
 			"password": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
@@ -94,7 +129,16 @@ func (r *SnowflakeIntegrationResource) Schema(ctx context.Context, req resource.
 			"private_key": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "Snowflake private key for key-pair authentication",
+				MarkdownDescription: "Snowflake private key for key-pair authentication. Must be a PEM encoded RSA key of at least 2048 bits in PKCS#8 or PKCS#1 format",
+			},
+			"private_key_passphrase": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Passphrase of an encrypted PKCS#8 private key",
+			},
+			"public_key_fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fingerprint of the public key matching private_key, in the format Snowflake reports as `RSA_PUBLIC_KEY_FP` (`SHA256:...`)",
 			},
 			"role": schema.StringAttribute{
 				Optional:            true,
@@ -106,15 +150,30 @@ func (r *SnowflakeIntegrationResource) Schema(ctx context.Context, req resource.
 			},
 			"database": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Snowflake database",
+				MarkdownDescription: "Snowflake database. Conflicts with databases",
+			},
+			"databases": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Snowflake databases to observe, as names, glob patterns (e.g. `ANALYTICS_*`) or regular expressions enclosed in slashes (e.g. `/^PROD_.+$/`). Conflicts with database",
+			},
+			"include_schemas": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Glob patterns or regular expressions enclosed in slashes for the schemas to observe. All schemas are observed when not set",
+			},
+			"exclude_schemas": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Glob patterns or regular expressions enclosed in slashes for the schemas to skip. Takes precedence over include_schemas",
 			},
 			"table_to_use_for_query_history": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Table to use for query history (defaults to snowflake.account_usage.query_history)",
+				MarkdownDescription: "Fully qualified table to use for query history, as database.schema.table (defaults to snowflake.account_usage.query_history)",
 			},
 			"additional_where_clause_for_query_history_query": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Additional WHERE clause to add to the query history query",
+				MarkdownDescription: "Additional WHERE clause to add to the query history query. Must be a single expression without statement terminators",
 			},
 			"override_platform_uri": schema.StringAttribute{
 				Optional:            true,
@@ -208,114 +267,460 @@ func (r *SnowflakeIntegrationResource) Schema(ctx context.Context, req resource.
 	}
 }
 
-// Create creates the resource and sets the initial Terraform state.
-func (r *SnowflakeIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data SnowflakeIntegrationResourceModel
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+// snowflakeConfigurationToAPI converts the Terraform Snowflake configuration to API format
+func snowflakeConfigurationToAPI(config SnowflakeConfigurationModel) map[string]interface{} {
+	configMap := map[string]interface{}{
+		"host": snowflakeHostToAPI(config.Host.ValueString()),
+		"user": config.User.ValueString(),
+	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	// Add optional fields
+	if !config.AuthenticationMethod.IsNull() && !config.AuthenticationMethod.IsUnknown() {
+		configMap["authentication_method"] = config.AuthenticationMethod.ValueString()
+	}
+	if !config.Password.IsNull() && !config.Password.IsUnknown() {
+		configMap["password"] = config.Password.ValueString()
+	}
+	if !config.PrivateKey.IsNull() && !config.PrivateKey.IsUnknown() {
+		configMap["private_key"] = config.PrivateKey.ValueString()
+	}
+	if !config.PrivateKeyPassphrase.IsNull() && !config.PrivateKeyPassphrase.IsUnknown() {
+		configMap["private_key_passphrase"] = config.PrivateKeyPassphrase.ValueString()
+	}
+	if !config.Role.IsNull() && !config.Role.IsUnknown() {
+		configMap["role"] = config.Role.ValueString()
+	}
+	if !config.Warehouse.IsNull() && !config.Warehouse.IsUnknown() {
+		configMap["warehouse"] = config.Warehouse.ValueString()
+	}
+	if !config.Database.IsNull() && !config.Database.IsUnknown() {
+		configMap["database"] = config.Database.ValueString()
+	}
+	if !config.Databases.IsNull() && !config.Databases.IsUnknown() {
+		configMap["databases"] = convertStringListToAPI(config.Databases)
+	}
+	if !config.IncludeSchemas.IsNull() && !config.IncludeSchemas.IsUnknown() {
+		configMap["include_schemas"] = convertStringListToAPI(config.IncludeSchemas)
+	}
+	if !config.ExcludeSchemas.IsNull() && !config.ExcludeSchemas.IsUnknown() {
+		configMap["exclude_schemas"] = convertStringListToAPI(config.ExcludeSchemas)
+	}
+	if !config.TableToUseForQueryHistory.IsNull() && !config.TableToUseForQueryHistory.IsUnknown() {
+		configMap["table_to_use_for_query_history"] = config.TableToUseForQueryHistory.ValueString()
+	}
+	if !config.AdditionalWhereClauseForQueryHistoryQuery.IsNull() && !config.AdditionalWhereClauseForQueryHistoryQuery.IsUnknown() {
+		configMap["additional_where_clause_for_query_history_query"] = config.AdditionalWhereClauseForQueryHistoryQuery.ValueString()
+	}
+	if !config.OverridePlatformURI.IsNull() && !config.OverridePlatformURI.IsUnknown() {
+		configMap["override_platform_uri"] = config.OverridePlatformURI.ValueString()
+	}
+	if !config.OverrideBaseURI.IsNull() && !config.OverrideBaseURI.IsUnknown() {
+		configMap["override_base_uri"] = config.OverrideBaseURI.ValueString()
+	}
+	if !config.ExtractViews.IsNull() && !config.ExtractViews.IsUnknown() {
+		configMap["extract_views"] = config.ExtractViews.ValueBool()
+	}
+	if !config.ExtractTables.IsNull() && !config.ExtractTables.IsUnknown() {
+		configMap["extract_tables"] = config.ExtractTables.ValueBool()
+	}
+	if !config.ExtractTableauUsage.IsNull() && !config.ExtractTableauUsage.IsUnknown() {
+		configMap["extract_tableau_usage"] = config.ExtractTableauUsage.ValueBool()
+	}
+	if !config.ExtractDailyUsage.IsNull() && !config.ExtractDailyUsage.IsUnknown() {
+		configMap["extract_daily_usage"] = config.ExtractDailyUsage.ValueBool()
+	}
+	if !config.ExtractDailyDMLSummary.IsNull() && !config.ExtractDailyDMLSummary.IsUnknown() {
+		configMap["extract_daily_dml_summary"] = config.ExtractDailyDMLSummary.ValueBool()
+	}
+	if !config.ExtractMaterializedViewsRefreshHistory.IsNull() && !config.ExtractMaterializedViewsRefreshHistory.IsUnknown() {
+		configMap["extract_materialized_views_refresh_history"] = config.ExtractMaterializedViewsRefreshHistory.ValueBool()
+	}
+	if !config.ExtractHexUsage.IsNull() && !config.ExtractHexUsage.IsUnknown() {
+		configMap["extract_hex_usage"] = config.ExtractHexUsage.ValueBool()
+	}
+	if !config.ExtractHexLineage.IsNull() && !config.ExtractHexLineage.IsUnknown() {
+		configMap["extract_hex_lineage"] = config.ExtractHexLineage.ValueBool()
+	}
+	if !config.ExtractHexLineageLookbackDays.IsNull() && !config.ExtractHexLineageLookbackDays.IsUnknown() {
+		configMap["extract_hex_lineage_lookback_days"] = config.ExtractHexLineageLookbackDays.ValueInt64()
+	}
+	if !config.CostPerCredit.IsNull() && !config.CostPerCredit.IsUnknown() {
+		configMap["cost_per_credit"] = config.CostPerCredit.ValueFloat64()
+	}
+	if !config.StorageCostPerTB.IsNull() && !config.StorageCostPerTB.IsUnknown() {
+		configMap["storage_cost_per_tb"] = config.StorageCostPerTB.ValueFloat64()
+	}
+	if !config.ObserveWarehouses.IsNull() && !config.ObserveWarehouses.IsUnknown() {
+		configMap["observe_warehouses"] = config.ObserveWarehouses.ValueBool()
+	}
+	if !config.UseSnowflakeDatabase.IsNull() && !config.UseSnowflakeDatabase.IsUnknown() {
+		configMap["use_snowflake_database"] = config.UseSnowflakeDatabase.ValueBool()
+	}
+	if !config.ExtractLineageFromQueryHistory.IsNull() && !config.ExtractLineageFromQueryHistory.IsUnknown() {
+		configMap["extract_lineage_from_query_history"] = config.ExtractLineageFromQueryHistory.ValueBool()
+	}
+	if !config.LineageLookbackDays.IsNull() && !config.LineageLookbackDays.IsUnknown() {
+		configMap["lineage_lookback_days"] = config.LineageLookbackDays.ValueInt64()
+	}
+	if !config.ObserveInboundShares.IsNull() && !config.ObserveInboundShares.IsUnknown() {
+		configMap["observe_inbound_shares"] = config.ObserveInboundShares.ValueBool()
 	}
 
-	// Convert configuration to API format
-	configMap := map[string]interface{}{
-		"host": data.Configuration.Host.ValueString(),
-		"user": data.Configuration.User.ValueString(),
+	return configMap
+}
+
+// snowflakeConfigurationFromAPI maps the configuration returned by the API onto the current
+// configuration. Credentials are kept as configured since the API does not return them in
+// plain text, and computed values the API does not return are resolved to null.
+func snowflakeConfigurationFromAPI(current SnowflakeConfigurationModel, apiConfig map[string]interface{}) SnowflakeConfigurationModel {
+	config := current
+
+	setString := func(key string, target *types.String) {
+		if v, ok := apiConfig[key].(string); ok && (v != "" || !target.IsNull()) {
+			*target = types.StringValue(v)
+		}
+	}
+	setList := func(key string, target *types.List) {
+		v, ok := apiConfig[key]
+		if !ok {
+			return
+		}
+		// An empty list is equivalent to not setting the attribute
+		if list := convertStringListFromAPI(v); len(list.Elements()) > 0 || !target.IsNull() {
+			*target = list
+		}
+	}
+	setBool := func(key string, target *types.Bool) {
+		if v, ok := apiConfig[key].(bool); ok {
+			*target = types.BoolValue(v)
+		} else if target.IsUnknown() {
+			*target = types.BoolNull()
+		}
+	}
+	setInt64 := func(key string, target *types.Int64) {
+		if v, ok := apiConfig[key].(float64); ok {
+			*target = types.Int64Value(int64(v))
+		} else if target.IsUnknown() {
+			*target = types.Int64Null()
+		}
+	}
+	setFloat64 := func(key string, target *types.Float64) {
+		if v, ok := apiConfig[key].(float64); ok {
+			*target = types.Float64Value(v)
+		} else if target.IsUnknown() {
+			*target = types.Float64Null()
+		}
 	}
 
-	// Add optional fields
-	if !data.Configuration.Password.IsNull() {
-		configMap["password"] = data.Configuration.Password.ValueString()
+	if host, ok := apiConfig["host"].(string); ok {
+		config.Host = snowflakeHostFromAPI(current.Host, host)
+	}
+	setString("user", &config.User)
+	setString("authentication_method", &config.AuthenticationMethod)
+	setString("role", &config.Role)
+	setString("warehouse", &config.Warehouse)
+	setString("database", &config.Database)
+	setList("databases", &config.Databases)
+	setList("include_schemas", &config.IncludeSchemas)
+	setList("exclude_schemas", &config.ExcludeSchemas)
+	setString("table_to_use_for_query_history", &config.TableToUseForQueryHistory)
+	setString("additional_where_clause_for_query_history_query", &config.AdditionalWhereClauseForQueryHistoryQuery)
+	setString("override_platform_uri", &config.OverridePlatformURI)
+	setString("override_base_uri", &config.OverrideBaseURI)
+	setBool("extract_views", &config.ExtractViews)
+	setBool("extract_tables", &config.ExtractTables)
+	setBool("extract_tableau_usage", &config.ExtractTableauUsage)
+	setBool("extract_daily_usage", &config.ExtractDailyUsage)
+	setBool("extract_daily_dml_summary", &config.ExtractDailyDMLSummary)
+	setBool("extract_materialized_views_refresh_history", &config.ExtractMaterializedViewsRefreshHistory)
+	setBool("extract_hex_usage", &config.ExtractHexUsage)
+	setBool("extract_hex_lineage", &config.ExtractHexLineage)
+	setInt64("extract_hex_lineage_lookback_days", &config.ExtractHexLineageLookbackDays)
+	setFloat64("cost_per_credit", &config.CostPerCredit)
+	setFloat64("storage_cost_per_tb", &config.StorageCostPerTB)
+	setBool("observe_warehouses", &config.ObserveWarehouses)
+	setBool("use_snowflake_database", &config.UseSnowflakeDatabase)
+	setBool("extract_lineage_from_query_history", &config.ExtractLineageFromQueryHistory)
+	setInt64("lineage_lookback_days", &config.LineageLookbackDays)
+	setBool("observe_inbound_shares", &config.ObserveInboundShares)
+
+	if config.AuthenticationMethod.IsUnknown() {
+		config.AuthenticationMethod = inferSnowflakeAuthenticationMethod(config)
+	}
+
+	return config
+}
+
+// inferSnowflakeAuthenticationMethod infers the authentication method from the configured credentials
+func inferSnowflakeAuthenticationMethod(config SnowflakeConfigurationModel) types.String {
+	switch {
+	case config.PrivateKey.IsUnknown() || config.Password.IsUnknown():
+		return types.StringUnknown()
+	case !config.PrivateKey.IsNull():
+		return types.StringValue(snowflakeAuthKeyPair)
+	case !config.Password.IsNull():
+		return types.StringValue(snowflakeAuthPassword)
+	default:
+		return types.StringNull()
 	}
-	if !data.Configuration.PrivateKey.IsNull() {
-		configMap["private_key"] = data.Configuration.PrivateKey.ValueString()
+}
+
+// validateSnowflakeAuthentication checks that the credentials match exactly one authentication method
+func validateSnowflakeAuthentication(config SnowflakeConfigurationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config.AuthenticationMethod.IsUnknown() || config.Password.IsUnknown() || config.PrivateKey.IsUnknown() {
+		return diags
 	}
-	if !data.Configuration.Role.IsNull() {
-		configMap["role"] = data.Configuration.Role.ValueString()
+
+	methodPath := path.Root("configuration").AtName("authentication_method")
+	passwordPath := path.Root("configuration").AtName("password")
+	privateKeyPath := path.Root("configuration").AtName("private_key")
+	hasPassword := !config.Password.IsNull()
+	hasPrivateKey := !config.PrivateKey.IsNull()
+
+	method := ""
+	if !config.AuthenticationMethod.IsNull() {
+		method = config.AuthenticationMethod.ValueString()
+		if !slices.Contains(snowflakeAuthenticationMethods, method) {
+			diags.AddAttributeError(methodPath, "Invalid Snowflake Authentication Method", fmt.Sprintf("authentication_method must be one of %s, got: %q", strings.Join(snowflakeAuthenticationMethods, ", "), method))
+			return diags
+		}
 	}
-	if !data.Configuration.Warehouse.IsNull() {
-		configMap["warehouse"] = data.Configuration.Warehouse.ValueString()
+
+	switch method {
+	case "":
+		if hasPassword && hasPrivateKey {
+			diags.AddAttributeError(passwordPath, "Conflicting Snowflake Authentication", "Only one of password and private_key can be set")
+		} else if !hasPassword && !hasPrivateKey {
+			diags.AddAttributeError(privateKeyPath, "Missing Snowflake Authentication", "One of private_key or password must be set, or authentication_method must be set to oauth")
+		}
+	case snowflakeAuthKeyPair:
+		if !hasPrivateKey {
+			diags.AddAttributeError(privateKeyPath, "Missing Snowflake Private Key", "private_key must be set when authentication_method is key_pair")
+		}
+		if hasPassword {
+			diags.AddAttributeError(passwordPath, "Conflicting Snowflake Authentication", "password cannot be set when authentication_method is key_pair")
+		}
+	case snowflakeAuthPassword:
+		if !hasPassword {
+			diags.AddAttributeError(passwordPath, "Missing Snowflake Password", "password must be set when authentication_method is password")
+		}
+		if hasPrivateKey {
+			diags.AddAttributeError(privateKeyPath, "Conflicting Snowflake Authentication", "private_key cannot be set when authentication_method is password")
+		}
+	case snowflakeAuthOAuth:
+		if hasPassword || hasPrivateKey {
+			diags.AddAttributeError(methodPath, "Conflicting Snowflake Authentication", "password and private_key cannot be set when authentication_method is oauth")
+		}
 	}
-	if !data.Configuration.Database.IsNull() {
-		configMap["database"] = data.Configuration.Database.ValueString()
+
+	if hasPassword && !diags.HasError() {
+		diags.AddAttributeWarning(passwordPath, "Deprecated Snowflake Authentication Method", "Password authentication for Snowflake is deprecated and will be removed in a future version. Use key-pair authentication with private_key instead.")
 	}
-	if !data.Configuration.TableToUseForQueryHistory.IsNull() {
-		configMap["table_to_use_for_query_history"] = data.Configuration.TableToUseForQueryHistory.ValueString()
+
+	return diags
+}
+
+// snowflakePublicKeyFingerprintValue returns the fingerprint of the configured private key,
+// unknown while the key is not yet known and null without key-pair authentication.
+func snowflakePublicKeyFingerprintValue(config SnowflakeConfigurationModel) (types.String, error) {
+	if config.PrivateKey.IsUnknown() || config.PrivateKeyPassphrase.IsUnknown() {
+		return types.StringUnknown(), nil
 	}
-	if !data.Configuration.AdditionalWhereClauseForQueryHistoryQuery.IsNull() {
-		configMap["additional_where_clause_for_query_history_query"] = data.Configuration.AdditionalWhereClauseForQueryHistoryQuery.ValueString()
+	if config.PrivateKey.IsNull() {
+		return types.StringNull(), nil
 	}
-	if !data.Configuration.OverridePlatformURI.IsNull() {
-		configMap["override_platform_uri"] = data.Configuration.OverridePlatformURI.ValueString()
+
+	key, err := parseSnowflakePrivateKey(config.PrivateKey.ValueString(), config.PrivateKeyPassphrase.ValueString())
+	if err != nil {
+		return types.StringNull(), err
 	}
-	if !data.Configuration.OverrideBaseURI.IsNull() {
-		configMap["override_base_uri"] = data.Configuration.OverrideBaseURI.ValueString()
+
+	fingerprint, err := snowflakePublicKeyFingerprint(&key.PublicKey)
+	if err != nil {
+		return types.StringNull(), err
 	}
-	if !data.Configuration.ExtractViews.IsNull() {
-		configMap["extract_views"] = data.Configuration.ExtractViews.ValueBool()
+
+	return types.StringValue(fingerprint), nil
+}
+
+// ValidateConfig validates the Snowflake configuration at plan time.
+func (r *SnowflakeIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *SnowflakeConfigurationModel
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("configuration"), &config)...)
+
+	if resp.Diagnostics.HasError() || config == nil {
+		return
 	}
-	if !data.Configuration.ExtractTables.IsNull() {
-		configMap["extract_tables"] = data.Configuration.ExtractTables.ValueBool()
+
+	resp.Diagnostics.Append(validateSnowflakeAuthentication(*config)...)
+
+	if !config.Database.IsNull() && !config.Databases.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("configuration").AtName("databases"),
+			"Invalid Snowflake Configuration",
+			"database and databases cannot both be set, move database into databases",
+		)
+	}
+
+	resp.Diagnostics.Append(validatePatternList(config.Databases, path.Root("configuration").AtName("databases"))...)
+	resp.Diagnostics.Append(validatePatternList(config.IncludeSchemas, path.Root("configuration").AtName("include_schemas"))...)
+	resp.Diagnostics.Append(validatePatternList(config.ExcludeSchemas, path.Root("configuration").AtName("exclude_schemas"))...)
+
+	if !config.Host.IsNull() && !config.Host.IsUnknown() {
+		normalized, err := normalizeSnowflakeHost(config.Host.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("configuration").AtName("host"),
+				"Invalid Snowflake Host",
+				fmt.Sprintf("Unable to parse the Snowflake host: %s", err),
+			)
+		} else if normalized != config.Host.ValueString() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("configuration").AtName("host"),
+				"Non-Canonical Snowflake Host",
+				fmt.Sprintf("The host %q is sent to Euno as %q. Set host to the canonical form, or use provider::euno::normalize_snowflake_host, to silence this warning.", config.Host.ValueString(), normalized),
+			)
+		}
 	}
-	if !data.Configuration.ExtractTableauUsage.IsNull() {
-		configMap["extract_tableau_usage"] = data.Configuration.ExtractTableauUsage.ValueBool()
+
+	if v := config.AdditionalWhereClauseForQueryHistoryQuery; !v.IsNull() && !v.IsUnknown() {
+		if err := validateSnowflakeWhereClause(v.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("configuration").AtName("additional_where_clause_for_query_history_query"),
+				"Invalid Snowflake Query History Filter",
+				fmt.Sprintf("The where clause must be a single SQL expression: %s", err),
+			)
+		}
 	}
-	if !data.Configuration.ExtractDailyUsage.IsNull() {
-		configMap["extract_daily_usage"] = data.Configuration.ExtractDailyUsage.ValueBool()
+
+	if v := config.TableToUseForQueryHistory; !v.IsNull() && !v.IsUnknown() {
+		if err := validateSnowflakeTableName(v.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("configuration").AtName("table_to_use_for_query_history"),
+				"Invalid Snowflake Query History Table",
+				fmt.Sprintf("Unable to parse the query history table name: %s", err),
+			)
+		}
 	}
-	if !data.Configuration.ExtractDailyDMLSummary.IsNull() {
-		configMap["extract_daily_dml_summary"] = data.Configuration.ExtractDailyDMLSummary.ValueBool()
+
+	if config.PrivateKey.IsNull() && !config.PrivateKeyPassphrase.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("configuration").AtName("private_key_passphrase"),
+			"Invalid Snowflake Configuration",
+			"private_key_passphrase can only be set together with private_key",
+		)
+		return
 	}
-	if !data.Configuration.ExtractMaterializedViewsRefreshHistory.IsNull() {
-		configMap["extract_materialized_views_refresh_history"] = data.Configuration.ExtractMaterializedViewsRefreshHistory.ValueBool()
+
+	if _, err := snowflakePublicKeyFingerprintValue(*config); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("configuration").AtName("private_key"),
+			"Invalid Snowflake Private Key",
+			fmt.Sprintf("Unable to parse the Snowflake private key: %s", err),
+		)
 	}
-	if !data.Configuration.ExtractHexUsage.IsNull() {
-		configMap["extract_hex_usage"] = data.Configuration.ExtractHexUsage.ValueBool()
+}
+
+// ModifyPlan computes the authentication method, public key fingerprint and required grants of the planned configuration.
+func (r *SnowflakeIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
 	}
-	if !data.Configuration.ExtractHexLineage.IsNull() {
-		configMap["extract_hex_lineage"] = data.Configuration.ExtractHexLineage.ValueBool()
+
+	var config *SnowflakeConfigurationModel
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("configuration"), &config)...)
+
+	if resp.Diagnostics.HasError() || config == nil {
+		return
 	}
-	if !data.Configuration.ExtractHexLineageLookbackDays.IsNull() {
-		configMap["extract_hex_lineage_lookback_days"] = data.Configuration.ExtractHexLineageLookbackDays.ValueInt64()
+
+	// Infer the authentication method from the credentials when it is not set explicitly
+	var method types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("configuration").AtName("authentication_method"), &method)...)
+	if method.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("configuration").AtName("authentication_method"), inferSnowflakeAuthenticationMethod(*config))...)
 	}
-	if !data.Configuration.CostPerCredit.IsNull() {
-		configMap["cost_per_credit"] = data.Configuration.CostPerCredit.ValueFloat64()
+
+	// Render the grants from the configuration, where unset features are null rather than unknown
+	var configured SnowflakeConfigurationModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("configuration"), &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if !data.Configuration.StorageCostPerTB.IsNull() {
-		configMap["storage_cost_per_tb"] = data.Configuration.StorageCostPerTB.ValueFloat64()
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("required_grants_sql"), renderSnowflakeGrants(configured))...)
+
+	// Invalid keys are reported by ValidateConfig
+	fingerprint, err := snowflakePublicKeyFingerprintValue(*config)
+	if err != nil {
+		return
 	}
-	if !data.Configuration.ObserveWarehouses.IsNull() {
-		configMap["observe_warehouses"] = data.Configuration.ObserveWarehouses.ValueBool()
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("configuration").AtName("public_key_fingerprint"), fingerprint)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *SnowflakeIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeIntegrationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if !data.Configuration.UseSnowflakeDatabase.IsNull() {
-		configMap["use_snowflake_database"] = data.Configuration.UseSnowflakeDatabase.ValueBool()
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if !data.Configuration.ExtractLineageFromQueryHistory.IsNull() {
-		configMap["extract_lineage_from_query_history"] = data.Configuration.ExtractLineageFromQueryHistory.ValueBool()
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Convert configuration to API format
+	if data.Configuration.AuthenticationMethod.IsUnknown() {
+		data.Configuration.AuthenticationMethod = inferSnowflakeAuthenticationMethod(data.Configuration)
 	}
-	if !data.Configuration.LineageLookbackDays.IsNull() {
-		configMap["lineage_lookback_days"] = data.Configuration.LineageLookbackDays.ValueInt64()
+	configMap := snowflakeConfigurationToAPI(data.Configuration)
+
+	// Compute the fingerprint of the private key, which may have been unknown at plan time
+	fingerprint, err := snowflakePublicKeyFingerprintValue(data.Configuration)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("configuration").AtName("private_key"), "Invalid Snowflake Private Key", fmt.Sprintf("Unable to parse the Snowflake private key: %s", err))
+		return
 	}
-	if !data.Configuration.ObserveInboundShares.IsNull() {
-		configMap["observe_inbound_shares"] = data.Configuration.ObserveInboundShares.ValueBool()
+	data.Configuration.PublicKeyFingerprint = fingerprint
+
+	// Render the grants from the configuration, where unset features are null rather than unknown
+	var configured SnowflakeConfigurationModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("configuration"), &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.RequiredGrantsSQL = renderSnowflakeGrants(configured)
 
 	// Convert Terraform data to API format
 	integration := IntegrationIn{
-		IntegrationType:      "snowflake",
-		Name:                 data.Name.ValueString(),
-		Active:               data.Active.ValueBool(),
-		Configuration:        configMap,
-		Schedule:             convertScheduleToAPI(data.Schedule),
-		InvalidationStrategy: convertInvalidationStrategyToAPI(data.InvalidationStrategy),
+		IntegrationType:             r.integrationType,
+		Name:                        data.Name.ValueString(),
+		Active:                      data.Active.ValueBool(),
+		Configuration:               configMap,
+		Schedule:                    convertScheduleToAPI(data.Schedule),
+		InvalidationStrategy:        convertInvalidationStrategyToAPI(data.InvalidationStrategy),
+		PendingCredentialsLookupKey: data.PendingCredentialsLookupKey.ValueStringPointer(),
 	}
 
 	// Create the integration
 	result, err := r.client.CreateIntegration(ctx, integration)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Snowflake integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create Snowflake integration", err)
 		return
 	}
 
@@ -327,32 +732,10 @@ func (r *SnowflakeIntegrationResource) Create(ctx context.Context, req resource.
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
 	// Convert configuration back to Terraform format
-	if result.Configuration != nil {
-		if host, ok := result.Configuration["host"].(string); ok {
-			data.Configuration.Host = types.StringValue(host)
-		}
-		if user, ok := result.Configuration["user"].(string); ok {
-			data.Configuration.User = types.StringValue(user)
-		}
-		if password, ok := result.Configuration["password"].(string); ok {
-			data.Configuration.Password = types.StringValue(password)
-		}
-		if privateKey, ok := result.Configuration["private_key"].(string); ok {
-			data.Configuration.PrivateKey = types.StringValue(privateKey)
-		}
-		if role, ok := result.Configuration["role"].(string); ok {
-			data.Configuration.Role = types.StringValue(role)
-		}
-		if warehouse, ok := result.Configuration["warehouse"].(string); ok {
-			data.Configuration.Warehouse = types.StringValue(warehouse)
-		}
-		if database, ok := result.Configuration["database"].(string); ok {
-			data.Configuration.Database = types.StringValue(database)
-		}
-		// Add other fields as needed...
-	}
+	data.Configuration = snowflakeConfigurationFromAPI(data.Configuration, result.Configuration)
 
 	// Convert schedule and invalidation strategy back
 	data.Schedule = convertScheduleFromAPI(result.Schedule)
@@ -362,6 +745,11 @@ func (r *SnowflakeIntegrationResource) Create(ctx context.Context, req resource.
 		data.PendingCredentialsLookupKey = types.StringValue(*result.PendingCredentialsLookupKey)
 	}
 
+	// Wait for a run to complete when requested
+	if data.WaitForFirstRun.ValueBool() {
+		resp.Diagnostics.Append(r.waitForFirstRun(ctx, &data.BaseIntegrationResourceModel, "Snowflake")...)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -377,10 +765,20 @@ func (r *SnowflakeIntegrationResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the integration from the API
 	result, err := r.client.GetIntegration(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Snowflake integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read Snowflake integration", err)
 		return
 	}
 
@@ -392,17 +790,13 @@ func (r *SnowflakeIntegrationResource) Read(ctx context.Context, req resource.Re
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
 	// Convert configuration back to Terraform format
-	if result.Configuration != nil {
-		if host, ok := result.Configuration["host"].(string); ok {
-			data.Configuration.Host = types.StringValue(host)
-		}
-		if user, ok := result.Configuration["user"].(string); ok {
-			data.Configuration.User = types.StringValue(user)
-		}
-		// Add other fields as needed...
-	}
+	data.Configuration = snowflakeConfigurationFromAPI(data.Configuration, result.Configuration)
+
+	// Render the grants from the refreshed configuration, which also fills them in after import
+	data.RequiredGrantsSQL = renderSnowflakeGrants(data.Configuration)
 
 	// Convert schedule and invalidation strategy back
 	data.Schedule = convertScheduleFromAPI(result.Schedule)
@@ -427,35 +821,53 @@ func (r *SnowflakeIntegrationResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Convert configuration to API format (same as Create)
-	configMap := map[string]interface{}{
-		"host": data.Configuration.Host.ValueString(),
-		"user": data.Configuration.User.ValueString(),
+	if data.Configuration.AuthenticationMethod.IsUnknown() {
+		data.Configuration.AuthenticationMethod = inferSnowflakeAuthenticationMethod(data.Configuration)
 	}
+	configMap := snowflakeConfigurationToAPI(data.Configuration)
 
-	// Add optional fields (same logic as Create)
-	if !data.Configuration.Password.IsNull() {
-		configMap["password"] = data.Configuration.Password.ValueString()
+	// Compute the fingerprint of the private key, which may have been unknown at plan time
+	fingerprint, err := snowflakePublicKeyFingerprintValue(data.Configuration)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("configuration").AtName("private_key"), "Invalid Snowflake Private Key", fmt.Sprintf("Unable to parse the Snowflake private key: %s", err))
+		return
 	}
-	if !data.Configuration.PrivateKey.IsNull() {
-		configMap["private_key"] = data.Configuration.PrivateKey.ValueString()
+	data.Configuration.PublicKeyFingerprint = fingerprint
+
+	// Render the grants from the configuration, where unset features are null rather than unknown
+	var configured SnowflakeConfigurationModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("configuration"), &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	// Add other optional fields...
+	data.RequiredGrantsSQL = renderSnowflakeGrants(configured)
 
 	// Convert Terraform data to API format
 	integration := IntegrationIn{
-		IntegrationType:      "snowflake",
-		Name:                 data.Name.ValueString(),
-		Active:               data.Active.ValueBool(),
-		Configuration:        configMap,
-		Schedule:             convertScheduleToAPI(data.Schedule),
-		InvalidationStrategy: convertInvalidationStrategyToAPI(data.InvalidationStrategy),
+		IntegrationType:             r.integrationType,
+		Name:                        data.Name.ValueString(),
+		Active:                      data.Active.ValueBool(),
+		Configuration:               configMap,
+		Schedule:                    convertScheduleToAPI(data.Schedule),
+		InvalidationStrategy:        convertInvalidationStrategyToAPI(data.InvalidationStrategy),
+		PendingCredentialsLookupKey: data.PendingCredentialsLookupKey.ValueStringPointer(),
 	}
 
 	// Update the integration
 	result, err := r.client.UpdateIntegration(ctx, int(data.ID.ValueInt64()), integration)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Snowflake integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update Snowflake integration", err)
 		return
 	}
 
@@ -467,17 +879,10 @@ func (r *SnowflakeIntegrationResource) Update(ctx context.Context, req resource.
 	}
 	data.CreatedAt = types.StringValue(result.CreatedAt)
 	data.LastUpdatedAt = types.StringValue(result.LastUpdatedAt)
+	data.IntegrationStatusModel = convertStatusFromAPI(result)
 
-	// Convert configuration back to Terraform format (same as Create)
-	if result.Configuration != nil {
-		if host, ok := result.Configuration["host"].(string); ok {
-			data.Configuration.Host = types.StringValue(host)
-		}
-		if user, ok := result.Configuration["user"].(string); ok {
-			data.Configuration.User = types.StringValue(user)
-		}
-		// Add other fields as needed...
-	}
+	// Convert configuration back to Terraform format
+	data.Configuration = snowflakeConfigurationFromAPI(data.Configuration, result.Configuration)
 
 	// Convert schedule and invalidation strategy back
 	data.Schedule = convertScheduleFromAPI(result.Schedule)
@@ -487,6 +892,11 @@ func (r *SnowflakeIntegrationResource) Update(ctx context.Context, req resource.
 		data.PendingCredentialsLookupKey = types.StringValue(*result.PendingCredentialsLookupKey)
 	}
 
+	// Wait for a run to complete when requested
+	if data.WaitForFirstRun.ValueBool() {
+		resp.Diagnostics.Append(r.waitForFirstRun(ctx, &data.BaseIntegrationResourceModel, "Snowflake")...)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -502,10 +912,20 @@ func (r *SnowflakeIntegrationResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the integration
 	err := r.client.DeleteIntegration(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Snowflake integration, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete Snowflake integration", err)
 		return
 	}
 }
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateSnowflakeAuthentication(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         types.String
+		password       types.String
+		privateKey     types.String
+		expectError    bool
+		expectWarning  bool
+		expectedMethod types.String
+	}{
+		{name: "inferred key pair", privateKey: types.StringValue("key"), expectedMethod: types.StringValue("key_pair")},
+		{name: "inferred password", password: types.StringValue("secret"), expectWarning: true, expectedMethod: types.StringValue("password")},
+		{name: "both credentials", password: types.StringValue("secret"), privateKey: types.StringValue("key"), expectError: true, expectedMethod: types.StringValue("key_pair")},
+		{name: "no credentials", expectError: true},
+		{name: "explicit key pair", method: types.StringValue("key_pair"), privateKey: types.StringValue("key"), expectedMethod: types.StringValue("key_pair")},
+		{name: "key pair without key", method: types.StringValue("key_pair"), password: types.StringValue("secret"), expectError: true, expectedMethod: types.StringValue("password")},
+		{name: "explicit password", method: types.StringValue("password"), password: types.StringValue("secret"), expectWarning: true, expectedMethod: types.StringValue("password")},
+		{name: "oauth", method: types.StringValue("oauth")},
+		{name: "oauth with key", method: types.StringValue("oauth"), privateKey: types.StringValue("key"), expectError: true, expectedMethod: types.StringValue("key_pair")},
+		{name: "unsupported method", method: types.StringValue("saml"), privateKey: types.StringValue("key"), expectError: true, expectedMethod: types.StringValue("key_pair")},
+		{name: "unknown credentials", password: types.StringUnknown(), expectedMethod: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := SnowflakeConfigurationModel{
+				AuthenticationMethod: tt.method,
+				Password:             tt.password,
+				PrivateKey:           tt.privateKey,
+			}
+
+			diags := validateSnowflakeAuthentication(config)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("expected error %t, got %v", tt.expectError, diags)
+			}
+			if hasWarning := diags.WarningsCount() > 0; hasWarning != tt.expectWarning {
+				t.Errorf("expected warning %t, got %v", tt.expectWarning, diags)
+			}
+			if inferred := inferSnowflakeAuthenticationMethod(config); !inferred.Equal(tt.expectedMethod) {
+				t.Errorf("expected inferred method %s, got %s", tt.expectedMethod, inferred)
+			}
+		})
+	}
+}
+
+func TestRenderSnowflakeGrants(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   SnowflakeConfigurationModel
+		expected types.String
+	}{
+		{
+			name: "defaults",
+			config: SnowflakeConfigurationModel{
+				User:      types.StringValue("EUNO_USER"),
+				Role:      types.StringValue("EUNO_ROLE"),
+				Warehouse: types.StringValue("EUNO_WH"),
+				Database:  types.StringValue("ANALYTICS"),
+			},
+			expected: types.StringValue(`GRANT ROLE EUNO_ROLE TO USER EUNO_USER;
+GRANT USAGE ON WAREHOUSE EUNO_WH TO ROLE EUNO_ROLE;
+GRANT USAGE ON DATABASE ANALYTICS TO ROLE EUNO_ROLE;
+GRANT USAGE ON ALL SCHEMAS IN DATABASE ANALYTICS TO ROLE EUNO_ROLE;
+GRANT USAGE ON FUTURE SCHEMAS IN DATABASE ANALYTICS TO ROLE EUNO_ROLE;
+GRANT REFERENCES ON ALL TABLES IN DATABASE ANALYTICS TO ROLE EUNO_ROLE;
+GRANT REFERENCES ON FUTURE TABLES IN DATABASE ANALYTICS TO ROLE EUNO_ROLE;
+GRANT REFERENCES ON ALL VIEWS IN DATABASE ANALYTICS TO ROLE EUNO_ROLE;
+GRANT REFERENCES ON FUTURE VIEWS IN DATABASE ANALYTICS TO ROLE EUNO_ROLE;
+GRANT IMPORTED PRIVILEGES ON DATABASE SNOWFLAKE TO ROLE EUNO_ROLE;
+-- Run GRANT IMPORTED PRIVILEGES ON DATABASE <share_db> TO ROLE EUNO_ROLE; for each database created from an inbound share
+`),
+		},
+		{
+			name: "minimal features",
+			config: SnowflakeConfigurationModel{
+				User:                           types.StringValue("euno.user"),
+				Role:                           types.StringValue("EUNO_ROLE"),
+				Warehouse:                      types.StringValue("EUNO_WH"),
+				ExtractViews:                   types.BoolValue(false),
+				ExtractTables:                  types.BoolValue(false),
+				ExtractTableauUsage:            types.BoolValue(false),
+				ExtractDailyUsage:              types.BoolValue(false),
+				ExtractDailyDMLSummary:         types.BoolValue(false),
+				ExtractLineageFromQueryHistory: types.BoolValue(false),
+				ObserveInboundShares:           types.BoolValue(false),
+				ObserveWarehouses:              types.BoolValue(true),
+			},
+			expected: types.StringValue(`GRANT ROLE EUNO_ROLE TO USER "euno.user";
+GRANT USAGE ON WAREHOUSE EUNO_WH TO ROLE EUNO_ROLE;
+GRANT MONITOR ON WAREHOUSE EUNO_WH TO ROLE EUNO_ROLE;
+GRANT MONITOR USAGE ON ACCOUNT TO ROLE EUNO_ROLE;
+`),
+		},
+		{
+			name: "custom query history table",
+			config: SnowflakeConfigurationModel{
+				User:                      types.StringValue("EUNO_USER"),
+				Role:                      types.StringValue("EUNO_ROLE"),
+				TableToUseForQueryHistory: types.StringValue("AUDIT.PUBLIC.QUERY_HISTORY"),
+				ExtractViews:              types.BoolValue(false),
+				ExtractTables:             types.BoolValue(false),
+				ObserveInboundShares:      types.BoolValue(false),
+			},
+			expected: types.StringValue(`GRANT ROLE EUNO_ROLE TO USER EUNO_USER;
+GRANT IMPORTED PRIVILEGES ON DATABASE SNOWFLAKE TO ROLE EUNO_ROLE;
+GRANT SELECT ON TABLE AUDIT.PUBLIC.QUERY_HISTORY TO ROLE EUNO_ROLE;
+`),
+		},
+		{
+			name: "multiple databases",
+			config: SnowflakeConfigurationModel{
+				User:                           types.StringValue("EUNO_USER"),
+				Role:                           types.StringValue("EUNO_ROLE"),
+				Databases:                      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("RAW"), types.StringValue("ANALYTICS_*")}),
+				ExtractViews:                   types.BoolValue(false),
+				UseSnowflakeDatabase:           types.BoolValue(false),
+				ExtractTableauUsage:            types.BoolValue(false),
+				ExtractDailyUsage:              types.BoolValue(false),
+				ExtractDailyDMLSummary:         types.BoolValue(false),
+				ExtractLineageFromQueryHistory: types.BoolValue(false),
+				ObserveInboundShares:           types.BoolValue(false),
+			},
+			expected: types.StringValue(`GRANT ROLE EUNO_ROLE TO USER EUNO_USER;
+GRANT USAGE ON DATABASE RAW TO ROLE EUNO_ROLE;
+GRANT USAGE ON ALL SCHEMAS IN DATABASE RAW TO ROLE EUNO_ROLE;
+GRANT USAGE ON FUTURE SCHEMAS IN DATABASE RAW TO ROLE EUNO_ROLE;
+GRANT REFERENCES ON ALL TABLES IN DATABASE RAW TO ROLE EUNO_ROLE;
+GRANT REFERENCES ON FUTURE TABLES IN DATABASE RAW TO ROLE EUNO_ROLE;
+-- Grant USAGE and REFERENCES on each database matching ANALYTICS_* individually
+`),
+		},
+		{
+			name:     "no role",
+			config:   SnowflakeConfigurationModel{User: types.StringValue("EUNO_USER")},
+			expected: types.StringNull(),
+		},
+		{
+			name:     "unknown role",
+			config:   SnowflakeConfigurationModel{User: types.StringValue("EUNO_USER"), Role: types.StringUnknown()},
+			expected: types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := renderSnowflakeGrants(tt.config); !actual.Equal(tt.expected) {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSnowflakeConfigurationFromAPI(t *testing.T) {
+	current := SnowflakeConfigurationModel{
+		Host:                 types.StringValue("MyOrg-MyAccount"),
+		User:                 types.StringValue("EUNO_USER"),
+		AuthenticationMethod: types.StringUnknown(),
+		PrivateKey:           types.StringValue("key"),
+		Databases:            types.ListValueMust(types.StringType, []attr.Value{types.StringValue("RAW")}),
+		ExtractViews:         types.BoolUnknown(),
+		ExtractTables:        types.BoolUnknown(),
+		LineageLookbackDays:  types.Int64Unknown(),
+		CostPerCredit:        types.Float64Unknown(),
+		IncludeSchemas:       types.ListNull(types.StringType),
+		ExcludeSchemas:       types.ListNull(types.StringType),
+	}
+
+	config := snowflakeConfigurationFromAPI(current, map[string]interface{}{
+		"host":                  "myorg-myaccount.snowflakecomputing.com",
+		"user":                  "EUNO_USER",
+		"authentication_method": "key_pair",
+		"private_key":           "********",
+		"databases":             []interface{}{"RAW", "ANALYTICS"},
+		"include_schemas":       []interface{}{},
+		"extract_views":         false,
+		"lineage_lookback_days": float64(14),
+		"cost_per_credit":       2.5,
+	})
+
+	if !config.Host.Equal(types.StringValue("MyOrg-MyAccount")) {
+		t.Errorf("expected configured host to be kept, got %s", config.Host)
+	}
+	if !config.AuthenticationMethod.Equal(types.StringValue("key_pair")) {
+		t.Errorf("expected authentication method key_pair, got %s", config.AuthenticationMethod)
+	}
+	if !config.PrivateKey.Equal(types.StringValue("key")) {
+		t.Errorf("expected configured private key to be kept, got %s", config.PrivateKey)
+	}
+	if expected := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("RAW"), types.StringValue("ANALYTICS")}); !config.Databases.Equal(expected) {
+		t.Errorf("expected databases drift to be detected, got %s", config.Databases)
+	}
+	if !config.IncludeSchemas.IsNull() {
+		t.Errorf("expected empty include_schemas to stay null, got %s", config.IncludeSchemas)
+	}
+	if !config.ExtractViews.Equal(types.BoolValue(false)) {
+		t.Errorf("expected extract_views false, got %s", config.ExtractViews)
+	}
+	if !config.ExtractTables.IsNull() {
+		t.Errorf("expected unreturned extract_tables to be null, got %s", config.ExtractTables)
+	}
+	if !config.LineageLookbackDays.Equal(types.Int64Value(14)) {
+		t.Errorf("expected lineage_lookback_days 14, got %s", config.LineageLookbackDays)
+	}
+	if !config.CostPerCredit.Equal(types.Float64Value(2.5)) {
+		t.Errorf("expected cost_per_credit 2.5, got %s", config.CostPerCredit)
+	}
+}
+
+func TestSnowflakeIntegrationReadAfterImport(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		active := true
+		_ = json.NewEncoder(w).Encode(IntegrationOut{
+			ID:              7,
+			Name:            "warehouse",
+			IntegrationType: "snowflake",
+			Active:          &active,
+			Configuration: map[string]interface{}{
+				"host":                   "myorg-myaccount",
+				"user":                   "EUNO_USER",
+				"role":                   "EUNO_ROLE",
+				"extract_views":          false,
+				"extract_tables":         false,
+				"observe_inbound_shares": false,
+			},
+		})
+	}))
+	defer server.Close()
+
+	r := NewSnowflakeIntegrationResource().(*SnowflakeIntegrationResource)
+	r.client = NewEunoClient(server.URL, "key", 1)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	importResp := resource.ImportStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "7"}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", importResp.Diagnostics)
+	}
+
+	resp := resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	// The grants are rendered on read, so the first plan after import does not show an update
+	var grants types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("required_grants_sql"), &grants)...)
+
+	expected := `GRANT ROLE EUNO_ROLE TO USER EUNO_USER;
+GRANT IMPORTED PRIVILEGES ON DATABASE SNOWFLAKE TO ROLE EUNO_ROLE;
+`
+	if !grants.Equal(types.StringValue(expected)) {
+		t.Errorf("expected grants %q, got %s", expected, grants)
+	}
+}
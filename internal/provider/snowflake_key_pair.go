@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure SnowflakeKeyPairResource satisfies various resource interfaces.
+var _ resource.Resource = &SnowflakeKeyPairResource{}
+var _ resource.ResourceWithValidateConfig = &SnowflakeKeyPairResource{}
+
+// SnowflakeKeyPairResourceModel describes the Snowflake key pair resource data model.
+type SnowflakeKeyPairResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	RSABits              types.Int64  `tfsdk:"rsa_bits"`
+	User                 types.String `tfsdk:"user"`
+	Keepers              types.Map    `tfsdk:"keepers"`
+	PrivateKeyPEM        types.String `tfsdk:"private_key_pem"`
+	PublicKeyPEM         types.String `tfsdk:"public_key_pem"`
+	PublicKey            types.String `tfsdk:"public_key"`
+	PublicKeyFingerprint types.String `tfsdk:"public_key_fingerprint"`
+	AlterUserSQL         types.String `tfsdk:"alter_user_sql"`
+}
+
+// SnowflakeKeyPairResource defines the Snowflake key pair resource implementation.
+// Keys are generated locally and never sent to Euno or Snowflake.
+type SnowflakeKeyPairResource struct{}
+
+// NewSnowflakeKeyPairResource is a helper function to simplify the provider server and testing implementation.
+func NewSnowflakeKeyPairResource() resource.Resource {
+	return &SnowflakeKeyPairResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *SnowflakeKeyPairResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snowflake_key_pair"
+}
+
+// Schema defines the schema for the resource.
+func (r *SnowflakeKeyPairResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates an RSA key pair locally for Snowflake key-pair authentication. The private key is stored in the Terraform state as a sensitive value",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The fingerprint of the public key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rsa_bits": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(snowflakeMinKeyBits),
+				MarkdownDescription: "The size of the generated RSA key in bits (defaults to 2048, the minimum Snowflake accepts)",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The Snowflake user the key is assigned to. Used to render alter_user_sql",
+			},
+			"keepers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Arbitrary map of values that, when changed, generates a new key pair",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key_pem": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The private key in unencrypted PKCS#8 PEM format, ready for euno_snowflake_integration's configuration.private_key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key_pem": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The public key in PEM format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The base64 encoded public key without PEM header and footer, as expected by Snowflake's RSA_PUBLIC_KEY property",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key_fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The fingerprint of the public key, in the format Snowflake reports as `RSA_PUBLIC_KEY_FP` (`SHA256:...`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alter_user_sql": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The `ALTER USER ... SET RSA_PUBLIC_KEY=` statement that assigns the public key to user. Null when user is not set",
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the key size at plan time.
+func (r *SnowflakeKeyPairResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var bits types.Int64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rsa_bits"), &bits)...)
+
+	if resp.Diagnostics.HasError() || bits.IsNull() || bits.IsUnknown() {
+		return
+	}
+
+	if bits.ValueInt64() < snowflakeMinKeyBits {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rsa_bits"),
+			"Invalid Key Size",
+			fmt.Sprintf("Snowflake requires RSA keys of at least %d bits, got: %d", snowflakeMinKeyBits, bits.ValueInt64()),
+		)
+	}
+}
+
+// Create generates the key pair and sets the initial Terraform state.
+func (r *SnowflakeKeyPairResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnowflakeKeyPairResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyPair, err := generateSnowflakeKeyPair(int(data.RSABits.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Key Generation Error", fmt.Sprintf("Unable to generate Snowflake key pair, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(keyPair.Fingerprint)
+	data.PrivateKeyPEM = types.StringValue(keyPair.PrivateKeyPEM)
+	data.PublicKeyPEM = types.StringValue(keyPair.PublicKeyPEM)
+	data.PublicKey = types.StringValue(keyPair.PublicKey)
+	data.PublicKeyFingerprint = types.StringValue(keyPair.Fingerprint)
+	data.AlterUserSQL = snowflakeAlterUserSQL(data.User, data.PublicKey)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state. The key pair only exists in state, so there is nothing to refresh.
+func (r *SnowflakeKeyPairResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update re-renders alter_user_sql when the user changes. All other changes replace the key pair.
+func (r *SnowflakeKeyPairResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SnowflakeKeyPairResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.AlterUserSQL = snowflakeAlterUserSQL(data.User, data.PublicKey)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the key pair from the Terraform state.
+func (r *SnowflakeKeyPairResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// snowflakeAlterUserSQL renders the statement assigning the public key to the Snowflake user
+func snowflakeAlterUserSQL(user, publicKey types.String) types.String {
+	if user.IsNull() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(fmt.Sprintf("ALTER USER %s SET RSA_PUBLIC_KEY='%s';", snowflakeIdentifier(user.ValueString()), publicKey.ValueString()))
+}
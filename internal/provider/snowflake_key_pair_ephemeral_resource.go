@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &SnowflakeKeyPairEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &SnowflakeKeyPairEphemeralResource{}
+
+// NewSnowflakeKeyPairEphemeralResource is a helper function to simplify the provider implementation.
+func NewSnowflakeKeyPairEphemeralResource() ephemeral.EphemeralResource {
+	return &SnowflakeKeyPairEphemeralResource{}
+}
+
+// SnowflakeKeyPairEphemeralResource defines the ephemeral resource implementation.
+// A new key pair is generated every time the resource is opened and never stored.
+type SnowflakeKeyPairEphemeralResource struct{}
+
+// SnowflakeKeyPairEphemeralResourceModel describes the ephemeral resource data model.
+type SnowflakeKeyPairEphemeralResourceModel struct {
+	RSABits              types.Int64  `tfsdk:"rsa_bits"`
+	User                 types.String `tfsdk:"user"`
+	PrivateKeyPEM        types.String `tfsdk:"private_key_pem"`
+	PublicKeyPEM         types.String `tfsdk:"public_key_pem"`
+	PublicKey            types.String `tfsdk:"public_key"`
+	PublicKeyFingerprint types.String `tfsdk:"public_key_fingerprint"`
+	AlterUserSQL         types.String `tfsdk:"alter_user_sql"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *SnowflakeKeyPairEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snowflake_key_pair"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *SnowflakeKeyPairEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates an RSA key pair locally for Snowflake key-pair authentication without storing it in the plan or state. A new key pair is generated on every run",
+
+		Attributes: map[string]schema.Attribute{
+			"rsa_bits": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The size of the generated RSA key in bits (defaults to 2048, the minimum Snowflake accepts)",
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The Snowflake user the key is assigned to. Used to render alter_user_sql",
+			},
+			"private_key_pem": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The private key in unencrypted PKCS#8 PEM format",
+			},
+			"public_key_pem": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The public key in PEM format",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The base64 encoded public key without PEM header and footer, as expected by Snowflake's RSA_PUBLIC_KEY property",
+			},
+			"public_key_fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The fingerprint of the public key, in the format Snowflake reports as `RSA_PUBLIC_KEY_FP` (`SHA256:...`)",
+			},
+			"alter_user_sql": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The `ALTER USER ... SET RSA_PUBLIC_KEY=` statement that assigns the public key to user. Null when user is not set",
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the key size at plan time.
+func (e *SnowflakeKeyPairEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var bits types.Int64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rsa_bits"), &bits)...)
+
+	if resp.Diagnostics.HasError() || bits.IsNull() || bits.IsUnknown() {
+		return
+	}
+
+	if bits.ValueInt64() < snowflakeMinKeyBits {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rsa_bits"),
+			"Invalid Key Size",
+			fmt.Sprintf("Snowflake requires RSA keys of at least %d bits, got: %d", snowflakeMinKeyBits, bits.ValueInt64()),
+		)
+	}
+}
+
+// Open generates the key pair.
+func (e *SnowflakeKeyPairEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SnowflakeKeyPairEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bits := int64(snowflakeMinKeyBits)
+	if !data.RSABits.IsNull() {
+		bits = data.RSABits.ValueInt64()
+	}
+
+	keyPair, err := generateSnowflakeKeyPair(int(bits))
+	if err != nil {
+		resp.Diagnostics.AddError("Key Generation Error", fmt.Sprintf("Unable to generate Snowflake key pair, got error: %s", err))
+		return
+	}
+
+	data.RSABits = types.Int64Value(bits)
+	data.PrivateKeyPEM = types.StringValue(keyPair.PrivateKeyPEM)
+	data.PublicKeyPEM = types.StringValue(keyPair.PublicKeyPEM)
+	data.PublicKey = types.StringValue(keyPair.PublicKey)
+	data.PublicKeyFingerprint = types.StringValue(keyPair.Fingerprint)
+	data.AlterUserSQL = snowflakeAlterUserSQL(data.User, data.PublicKey)
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
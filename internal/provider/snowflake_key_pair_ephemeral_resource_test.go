@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSnowflakeKeyPairEphemeralOpen(t *testing.T) {
+	ctx := context.Background()
+	e := NewSnowflakeKeyPairEphemeralResource()
+
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := func(bits any, user any) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"rsa_bits":               tftypes.NewValue(tftypes.Number, bits),
+				"user":                   tftypes.NewValue(tftypes.String, user),
+				"private_key_pem":        tftypes.NewValue(tftypes.String, nil),
+				"public_key_pem":         tftypes.NewValue(tftypes.String, nil),
+				"public_key":             tftypes.NewValue(tftypes.String, nil),
+				"public_key_fingerprint": tftypes.NewValue(tftypes.String, nil),
+				"alter_user_sql":         tftypes.NewValue(tftypes.String, nil),
+			}),
+		}
+	}
+
+	t.Run("validate", func(t *testing.T) {
+		var resp ephemeral.ValidateConfigResponse
+		e.(ephemeral.EphemeralResourceWithValidateConfig).ValidateConfig(ctx, ephemeral.ValidateConfigRequest{Config: config(int64(1024), nil)}, &resp)
+		if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "Invalid Key Size" {
+			t.Fatalf("expected error %q, got %v", "Invalid Key Size", resp.Diagnostics)
+		}
+	})
+
+	t.Run("open", func(t *testing.T) {
+		resp := ephemeral.OpenResponse{
+			Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+		}
+
+		e.Open(ctx, ephemeral.OpenRequest{Config: config(nil, "SVC_EUNO")}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		var result SnowflakeKeyPairEphemeralResourceModel
+		resp.Diagnostics.Append(resp.Result.Get(ctx, &result)...)
+		if result.RSABits.ValueInt64() != snowflakeMinKeyBits {
+			t.Errorf("expected the default key size, got %s", result.RSABits)
+		}
+
+		key, err := parseSnowflakePrivateKey(result.PrivateKeyPEM.ValueString(), "")
+		if err != nil {
+			t.Fatalf("unexpected error parsing the private key: %s", err)
+		}
+		fingerprint, err := snowflakePublicKeyFingerprint(&key.PublicKey)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if result.PublicKeyFingerprint.ValueString() != fingerprint {
+			t.Errorf("expected fingerprint %s, got %s", fingerprint, result.PublicKeyFingerprint)
+		}
+		if !strings.Contains(result.AlterUserSQL.ValueString(), result.PublicKey.ValueString()) {
+			t.Errorf("expected alter_user_sql to set the public key, got %s", result.AlterUserSQL)
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// snowflakeUnquotedIdentifier matches identifiers that can be used in Snowflake SQL without quoting
+var snowflakeUnquotedIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+// snowflakeIdentifier renders a Snowflake object name for use in SQL, quoting it when needed
+func snowflakeIdentifier(name string) string {
+	if snowflakeUnquotedIdentifier.MatchString(name) {
+		return name
+	}
+
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// validateSnowflakeWhereClause checks that a WHERE clause fragment is a single well-formed
+// expression. It tokenizes string literals, quoted identifiers, $$ strings and comments, and
+// rejects statement terminators as well as unterminated quotes, comments and unbalanced
+// parentheses. It does not validate the expression itself.
+func validateSnowflakeWhereClause(clause string) error {
+	depth := 0
+
+	for i := 0; i < len(clause); i++ {
+		switch c := clause[i]; {
+		case c == '\'' || c == '"':
+			end, err := skipSnowflakeQuoted(clause, i)
+			if err != nil {
+				return err
+			}
+			i = end
+		case strings.HasPrefix(clause[i:], "$$"):
+			end := strings.Index(clause[i+2:], "$$")
+			if end < 0 {
+				return fmt.Errorf("unterminated $$ string starting at position %d", i+1)
+			}
+			i += end + 3
+		case strings.HasPrefix(clause[i:], "--"), strings.HasPrefix(clause[i:], "//"):
+			end := strings.IndexByte(clause[i:], '\n')
+			if end < 0 {
+				return nil
+			}
+			i += end
+		case strings.HasPrefix(clause[i:], "/*"):
+			end := strings.Index(clause[i+2:], "*/")
+			if end < 0 {
+				return fmt.Errorf("unterminated comment starting at position %d", i+1)
+			}
+			i += end + 3
+		case c == '(':
+			depth++
+		case c == ')':
+			if depth == 0 {
+				return fmt.Errorf("unbalanced closing parenthesis at position %d", i+1)
+			}
+			depth--
+		case c == ';':
+			return fmt.Errorf("statement terminator ';' at position %d is not allowed", i+1)
+		}
+	}
+
+	if depth > 0 {
+		return fmt.Errorf("%d unclosed parenthesis", depth)
+	}
+
+	return nil
+}
+
+// skipSnowflakeQuoted returns the index of the quote closing the string literal or quoted
+// identifier that starts at start. Quotes are escaped by doubling them, and string literals
+// additionally support backslash escapes.
+func skipSnowflakeQuoted(s string, start int) (int, error) {
+	quote := s[start]
+
+	for i := start + 1; i < len(s); i++ {
+		switch {
+		case quote == '\'' && s[i] == '\\':
+			i++
+		case s[i] == quote && i+1 < len(s) && s[i+1] == quote:
+			i++
+		case s[i] == quote:
+			return i, nil
+		}
+	}
+
+	if quote == '"' {
+		return 0, fmt.Errorf("unterminated quoted identifier starting at position %d", start+1)
+	}
+	return 0, fmt.Errorf("unterminated string literal starting at position %d", start+1)
+}
+
+// splitSnowflakeQualifiedName splits a dot separated object name into its parts, honouring
+// quoted identifiers. Every part must be a valid unquoted or a non-empty quoted identifier.
+func splitSnowflakeQualifiedName(name string) ([]string, error) {
+	var parts []string
+
+	for i := 0; i <= len(name); {
+		var part string
+
+		if i < len(name) && name[i] == '"' {
+			end, err := skipSnowflakeQuoted(name, i)
+			if err != nil {
+				return nil, err
+			}
+			part = name[i : end+1]
+			if part == `""` {
+				return nil, errors.New("quoted identifiers must not be empty")
+			}
+			i = end + 1
+		} else {
+			end := strings.IndexByte(name[i:], '.')
+			if end < 0 {
+				end = len(name) - i
+			}
+			part = name[i : i+end]
+			if !snowflakeUnquotedIdentifier.MatchString(part) {
+				return nil, fmt.Errorf("%q is not a valid identifier, quote it with double quotes if it contains special characters", part)
+			}
+			i += end
+		}
+
+		parts = append(parts, part)
+
+		if i == len(name) {
+			break
+		}
+		if name[i] != '.' {
+			return nil, fmt.Errorf("unexpected character %q after identifier %s", name[i], part)
+		}
+		i++
+	}
+
+	return parts, nil
+}
+
+// validateSnowflakeTableName checks that the table name is fully qualified as database.schema.table
+func validateSnowflakeTableName(name string) error {
+	parts, err := splitSnowflakeQualifiedName(name)
+	if err != nil {
+		return err
+	}
+
+	if len(parts) != 3 {
+		return fmt.Errorf("table name must be fully qualified as database.schema.table, got %d part(s)", len(parts))
+	}
+
+	return nil
+}
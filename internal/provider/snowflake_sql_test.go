@@ -0,0 +1,68 @@
+package provider
+
+import "testing"
+
+func TestValidateSnowflakeWhereClause(t *testing.T) {
+	tests := []struct {
+		name        string
+		clause      string
+		expectError bool
+	}{
+		{name: "simple", clause: "user_name <> 'SYSTEM'"},
+		{name: "nested parentheses", clause: "(warehouse_name = 'ETL' OR (role_name IN ('A', 'B')))"},
+		{name: "semicolon in string", clause: "query_text NOT LIKE '%;%'"},
+		{name: "escaped quotes", clause: `query_tag = 'it''s' AND query_text <> 'a\'b;'`},
+		{name: "semicolon in quoted identifier", clause: `"col;umn" = 1`},
+		{name: "semicolon in dollar string", clause: "query_text <> $$;$$"},
+		{name: "semicolon in comment", clause: "user_name <> 'SYSTEM' /* ; */ -- trailing;"},
+		{name: "parenthesis in string", clause: "query_text LIKE '%(%'"},
+		{name: "statement terminator", clause: "user_name <> 'SYSTEM';", expectError: true},
+		{name: "stacked statement", clause: "1=1; DROP TABLE t", expectError: true},
+		{name: "unterminated string", clause: "user_name = 'SYSTEM", expectError: true},
+		{name: "unterminated identifier", clause: `"user_name = 1`, expectError: true},
+		{name: "unterminated dollar string", clause: "query_text = $$abc", expectError: true},
+		{name: "unterminated comment", clause: "1=1 /* comment", expectError: true},
+		{name: "unclosed parenthesis", clause: "(1=1", expectError: true},
+		{name: "unbalanced closing parenthesis", clause: "1=1)", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSnowflakeWhereClause(tt.clause)
+			if (err != nil) != tt.expectError {
+				t.Errorf("expected error %t, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestValidateSnowflakeTableName(t *testing.T) {
+	tests := []struct {
+		name        string
+		expectError bool
+	}{
+		{name: "snowflake.account_usage.query_history"},
+		{name: "AUDIT.PUBLIC.QUERY_HISTORY"},
+		{name: `"my db"."My.Schema".history$1`},
+		{name: `"a""b".s.t`},
+		{name: "query_history", expectError: true},
+		{name: "account_usage.query_history", expectError: true},
+		{name: "a.b.c.d", expectError: true},
+		{name: "a..c", expectError: true},
+		{name: "a.b.", expectError: true},
+		{name: "", expectError: true},
+		{name: `"".b.c`, expectError: true},
+		{name: `"a.b.c`, expectError: true},
+		{name: `"a"b.c.d`, expectError: true},
+		{name: "my db.s.t", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSnowflakeTableName(tt.name)
+			if (err != nil) != tt.expectError {
+				t.Errorf("expected error %t, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
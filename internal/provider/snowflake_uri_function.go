@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure SnowflakeURIFunction satisfies the function interface.
+var _ function.Function = &SnowflakeURIFunction{}
+
+// SnowflakeURIFunction defines the snowflake_uri function implementation.
+type SnowflakeURIFunction struct{}
+
+// NewSnowflakeURIFunction is a helper function to simplify the provider server and testing implementation.
+func NewSnowflakeURIFunction() function.Function {
+	return &SnowflakeURIFunction{}
+}
+
+// Metadata returns the function name.
+func (f *SnowflakeURIFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "snowflake_uri"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *SnowflakeURIFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build the Euno URI of a Snowflake table",
+		MarkdownDescription: "Builds the URI Euno assigns to a Snowflake table, using the same host normalization as euno_snowflake_integration",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "host",
+				MarkdownDescription: "The Snowflake host in any form supported by normalize_snowflake_host",
+			},
+			function.StringParameter{
+				Name:                "database",
+				MarkdownDescription: "The database name. Unquoted names are converted to lower case",
+			},
+			function.StringParameter{
+				Name:                "schema",
+				MarkdownDescription: "The schema name. Unquoted names are converted to lower case",
+			},
+			function.StringParameter{
+				Name:                "table",
+				MarkdownDescription: "The table name. Unquoted names are converted to lower case",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "override_base_uri",
+			AllowNullValue:      true,
+			MarkdownDescription: "The override_base_uri of the integration, used instead of the host when not null",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the URI.
+func (f *SnowflakeURIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host, database, schema, table string
+	var overrides []types.String
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &host, &database, &schema, &table, &overrides))
+
+	if resp.Error != nil {
+		return
+	}
+
+	var overrideBaseURI *string
+	switch len(overrides) {
+	case 0:
+	case 1:
+		overrideBaseURI = overrides[0].ValueStringPointer()
+	default:
+		resp.Error = function.NewArgumentFuncError(4, "override_base_uri can only be given once")
+		return
+	}
+
+	uri, err := snowflakeURI(host, overrideBaseURI, database, schema, table)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, uri))
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// snowflakeURINamespace is the first segment of the URIs Euno assigns to Snowflake assets
+const snowflakeURINamespace = "snowflake"
+
+// uriNamespacePattern matches the first segment of an Euno URI, such as snowflake or dbt
+var uriNamespacePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// EunoURI is an Euno URI split into its parts
+type EunoURI struct {
+	Namespace string
+	// Base identifies the source within the namespace, such as the Snowflake host or the dbt project
+	Base string
+	Path []string
+}
+
+// snowflakeURI builds the URI Euno assigns to a Snowflake table. The base is the override_base_uri
+// of the integration when set, and the normalized host otherwise.
+func snowflakeURI(host string, overrideBaseURI *string, database, schema, table string) (string, error) {
+	var base string
+	if overrideBaseURI != nil {
+		if err := validateURISegments("override_base_uri", *overrideBaseURI); err != nil {
+			return "", err
+		}
+		base = *overrideBaseURI
+	} else {
+		normalized, err := normalizeSnowflakeHost(host)
+		if err != nil {
+			return "", err
+		}
+		base = normalized
+	}
+
+	segments := []string{snowflakeURINamespace, base}
+	for _, identifier := range []struct{ name, value string }{
+		{"database", database},
+		{"schema", schema},
+		{"table", table},
+	} {
+		normalized, err := snowflakeURIIdentifier(identifier.name, identifier.value)
+		if err != nil {
+			return "", err
+		}
+		segments = append(segments, normalized)
+	}
+
+	return strings.Join(segments, "."), nil
+}
+
+// snowflakeURIIdentifier converts a Snowflake object name to its URI form. Unquoted identifiers
+// are case insensitive and converted to lower case. Quoted identifiers keep their case and
+// are unquoted. Dots cannot be represented in a URI segment and are rejected.
+func snowflakeURIIdentifier(name, value string) (string, error) {
+	if value == "" {
+		return "", fmt.Errorf("%s must not be empty", name)
+	}
+
+	var identifier string
+	switch {
+	case value[0] == '"':
+		end, err := skipSnowflakeQuoted(value, 0)
+		if err != nil || end != len(value)-1 {
+			return "", fmt.Errorf("%s %s is not a valid quoted identifier", name, value)
+		}
+		identifier = strings.ReplaceAll(value[1:end], `""`, `"`)
+		if identifier == "" {
+			return "", fmt.Errorf("%s must not be empty", name)
+		}
+	case snowflakeUnquotedIdentifier.MatchString(value):
+		identifier = strings.ToLower(value)
+	default:
+		return "", fmt.Errorf("%s %q is not a valid identifier, quote it with double quotes if it contains special characters", name, value)
+	}
+
+	if strings.Contains(identifier, ".") {
+		return "", fmt.Errorf("%s %s contains a dot, which cannot be represented in a URI", name, value)
+	}
+
+	return identifier, nil
+}
+
+// parseEunoURI splits an Euno URI into its namespace, base and path. The namespace is converted
+// to lower case. The base of a Snowflake URI is the account host when the URI contains one, and
+// the segment after the namespace otherwise. Other namespaces always have a single segment base.
+func parseEunoURI(uri string) (*EunoURI, error) {
+	if err := validateURISegments("uri", uri); err != nil {
+		return nil, err
+	}
+
+	segments := strings.Split(uri, ".")
+	if len(segments) < 2 {
+		return nil, fmt.Errorf("uri %q must have at least a namespace and a base", uri)
+	}
+
+	parsed := &EunoURI{Namespace: strings.ToLower(segments[0])}
+	if !uriNamespacePattern.MatchString(parsed.Namespace) {
+		return nil, fmt.Errorf("uri %q has an invalid namespace %q", uri, segments[0])
+	}
+
+	if parsed.Namespace == snowflakeURINamespace {
+		if end := snowflakeHostEnd(segments); end > 0 {
+			host, err := normalizeSnowflakeHost(strings.Join(segments[1:end], "."))
+			if err != nil {
+				return nil, fmt.Errorf("uri %q has an invalid Snowflake host: %w", uri, err)
+			}
+			segments = append([]string{segments[0], host}, segments[end:]...)
+		}
+	}
+
+	parsed.Base = segments[1]
+	parsed.Path = append([]string{}, segments[2:]...)
+
+	return parsed, nil
+}
+
+// snowflakeHostEnd returns the index of the segment after the Snowflake domain in the segments
+// of a URI, or 0 when the URI does not contain a Snowflake host
+func snowflakeHostEnd(segments []string) int {
+	for i := 2; i+1 < len(segments); i++ {
+		domain := "." + strings.ToLower(segments[i]+"."+segments[i+1])
+		for _, hostDomain := range snowflakeHostDomains {
+			if domain == hostDomain {
+				return i + 2
+			}
+		}
+	}
+
+	return 0
+}
+
+// validateURISegments checks that a URI or URI prefix consists of non-empty dot separated segments
+func validateURISegments(name, value string) error {
+	if value == "" {
+		return fmt.Errorf("%s must not be empty", name)
+	}
+	for _, segment := range strings.Split(value, ".") {
+		if segment == "" {
+			return fmt.Errorf("%s %q must not contain empty segments", name, value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSnowflakeURI(t *testing.T) {
+	override := func(value string) *string { return &value }
+
+	tests := []struct {
+		name            string
+		host            string
+		overrideBaseURI *string
+		database        string
+		schema          string
+		table           string
+		expected        string
+		expectedErr     string
+	}{
+		{
+			name: "organization account name", host: "myorg-myaccount",
+			database: "analytics", schema: "public", table: "orders",
+			expected: "snowflake.myorg-myaccount.snowflakecomputing.com.analytics.public.orders",
+		},
+		{
+			name: "account locator", host: "xy12345.us-east-2.aws",
+			database: "analytics", schema: "public", table: "orders",
+			expected: "snowflake.xy12345.us-east-2.aws.snowflakecomputing.com.analytics.public.orders",
+		},
+		{
+			name: "url", host: "https://MyOrg-MyAccount.snowflakecomputing.com:443/console",
+			database: "analytics", schema: "public", table: "orders",
+			expected: "snowflake.myorg-myaccount.snowflakecomputing.com.analytics.public.orders",
+		},
+		{
+			name: "snowsight url", host: "https://app.snowflake.com/myorg/myaccount/worksheets",
+			database: "analytics", schema: "public", table: "orders",
+			expected: "snowflake.myorg-myaccount.snowflakecomputing.com.analytics.public.orders",
+		},
+		{
+			name: "privatelink host", host: "myorg-myaccount.privatelink.snowflakecomputing.com",
+			database: "analytics", schema: "public", table: "orders",
+			expected: "snowflake.myorg-myaccount.privatelink.snowflakecomputing.com.analytics.public.orders",
+		},
+		{
+			name: "china host", host: "xy12345.cn-north-1.snowflakecomputing.cn",
+			database: "analytics", schema: "public", table: "orders",
+			expected: "snowflake.xy12345.cn-north-1.snowflakecomputing.cn.analytics.public.orders",
+		},
+		{
+			name: "unquoted identifiers are lower cased", host: "myorg-myaccount",
+			database: "ANALYTICS", schema: "Public", table: "ORDERS_2024$",
+			expected: "snowflake.myorg-myaccount.snowflakecomputing.com.analytics.public.orders_2024$",
+		},
+		{
+			name: "quoted identifiers keep their case", host: "myorg-myaccount",
+			database: `"Analytics"`, schema: `"My Schema"`, table: `"Say ""Hi"""`,
+			expected: `snowflake.myorg-myaccount.snowflakecomputing.com.Analytics.My Schema.Say "Hi"`,
+		},
+		{
+			name: "override base uri", host: "myorg-myaccount", overrideBaseURI: override("prod"),
+			database: "analytics", schema: "public", table: "orders",
+			expected: "snowflake.prod.analytics.public.orders",
+		},
+		{
+			name: "override base uri is used as given", host: "not a host", overrideBaseURI: override("Prod.Warehouse"),
+			database: "analytics", schema: "public", table: "orders",
+			expected: "snowflake.Prod.Warehouse.analytics.public.orders",
+		},
+		{
+			name: "invalid host", host: "my account",
+			database: "analytics", schema: "public", table: "orders",
+			expectedErr: `"my account" is not a valid Snowflake host`,
+		},
+		{
+			name: "empty host", host: "",
+			database: "analytics", schema: "public", table: "orders",
+			expectedErr: "host must not be empty",
+		},
+		{
+			name: "empty override base uri", host: "myorg-myaccount", overrideBaseURI: override(""),
+			database: "analytics", schema: "public", table: "orders",
+			expectedErr: "override_base_uri must not be empty",
+		},
+		{
+			name: "override base uri with empty segment", host: "myorg-myaccount", overrideBaseURI: override("prod."),
+			database: "analytics", schema: "public", table: "orders",
+			expectedErr: `override_base_uri "prod." must not contain empty segments`,
+		},
+		{
+			name: "empty database", host: "myorg-myaccount",
+			database: "", schema: "public", table: "orders",
+			expectedErr: "database must not be empty",
+		},
+		{
+			name: "empty quoted schema", host: "myorg-myaccount",
+			database: "analytics", schema: `""`, table: "orders",
+			expectedErr: "schema must not be empty",
+		},
+		{
+			name: "special characters in unquoted table", host: "myorg-myaccount",
+			database: "analytics", schema: "public", table: "my-table",
+			expectedErr: `table "my-table" is not a valid identifier, quote it with double quotes if it contains special characters`,
+		},
+		{
+			name: "unquoted identifier starting with a digit", host: "myorg-myaccount",
+			database: "1analytics", schema: "public", table: "orders",
+			expectedErr: `database "1analytics" is not a valid identifier, quote it with double quotes if it contains special characters`,
+		},
+		{
+			name: "unterminated quoted identifier", host: "myorg-myaccount",
+			database: "analytics", schema: "public", table: `"orders`,
+			expectedErr: `table "orders is not a valid quoted identifier`,
+		},
+		{
+			name: "text after quoted identifier", host: "myorg-myaccount",
+			database: "analytics", schema: "public", table: `"orders"x`,
+			expectedErr: `table "orders"x is not a valid quoted identifier`,
+		},
+		{
+			name: "qualified name", host: "myorg-myaccount",
+			database: "analytics", schema: "public", table: "public.orders",
+			expectedErr: `table "public.orders" is not a valid identifier, quote it with double quotes if it contains special characters`,
+		},
+		{
+			name: "dot in quoted identifier", host: "myorg-myaccount",
+			database: "analytics", schema: "public", table: `"v1.orders"`,
+			expectedErr: `table "v1.orders" contains a dot, which cannot be represented in a URI`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := snowflakeURI(tt.host, tt.overrideBaseURI, tt.database, tt.schema, tt.table)
+			if tt.expectedErr != "" {
+				if err == nil || err.Error() != tt.expectedErr {
+					t.Fatalf("expected error %q, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if actual != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseEunoURI(t *testing.T) {
+	tests := []struct {
+		uri         string
+		namespace   string
+		base        string
+		path        []string
+		expectedErr string
+	}{
+		{
+			uri:       "snowflake.myorg-myaccount.snowflakecomputing.com.analytics.public.orders",
+			namespace: "snowflake", base: "myorg-myaccount.snowflakecomputing.com", path: []string{"analytics", "public", "orders"},
+		},
+		{
+			uri:       "snowflake.xy12345.us-east-2.aws.snowflakecomputing.com.analytics.public.orders",
+			namespace: "snowflake", base: "xy12345.us-east-2.aws.snowflakecomputing.com", path: []string{"analytics", "public", "orders"},
+		},
+		{
+			uri:       "snowflake.myorg-myaccount.privatelink.snowflakecomputing.com.analytics",
+			namespace: "snowflake", base: "myorg-myaccount.privatelink.snowflakecomputing.com", path: []string{"analytics"},
+		},
+		{
+			uri:       "snowflake.xy12345.cn-north-1.snowflakecomputing.cn.analytics.public",
+			namespace: "snowflake", base: "xy12345.cn-north-1.snowflakecomputing.cn", path: []string{"analytics", "public"},
+		},
+		{
+			uri:       "Snowflake.MyOrg-MyAccount.SnowflakeComputing.com.Analytics.My Schema.orders",
+			namespace: "snowflake", base: "myorg-myaccount.snowflakecomputing.com", path: []string{"Analytics", "My Schema", "orders"},
+		},
+		{
+			uri:       "snowflake.myorg-myaccount.snowflakecomputing.com",
+			namespace: "snowflake", base: "myorg-myaccount.snowflakecomputing.com", path: []string{},
+		},
+		{
+			uri:       "snowflake.prod.analytics.public.orders",
+			namespace: "snowflake", base: "prod", path: []string{"analytics", "public", "orders"},
+		},
+		{
+			uri:       "dbt.myproject.model.orders",
+			namespace: "dbt", base: "myproject", path: []string{"model", "orders"},
+		},
+		{
+			uri:       "dbt.myproject",
+			namespace: "dbt", base: "myproject", path: []string{},
+		},
+		{
+			uri:       "tableau.site.workbook.snowflakecomputing.com",
+			namespace: "tableau", base: "site", path: []string{"workbook", "snowflakecomputing", "com"},
+		},
+		{uri: "", expectedErr: "uri must not be empty"},
+		{uri: "snowflake", expectedErr: `uri "snowflake" must have at least a namespace and a base`},
+		{uri: "snowflake..analytics", expectedErr: `uri "snowflake..analytics" must not contain empty segments`},
+		{uri: ".dbt.myproject", expectedErr: `uri ".dbt.myproject" must not contain empty segments`},
+		{uri: "dbt.myproject.", expectedErr: `uri "dbt.myproject." must not contain empty segments`},
+		{uri: "1dbt.myproject", expectedErr: `uri "1dbt.myproject" has an invalid namespace "1dbt"`},
+		{uri: "my dbt.myproject", expectedErr: `uri "my dbt.myproject" has an invalid namespace "my dbt"`},
+		{
+			uri:         "snowflake.my account.snowflakecomputing.com.analytics",
+			expectedErr: `uri "snowflake.my account.snowflakecomputing.com.analytics" has an invalid Snowflake host: "my account.snowflakecomputing.com" is not a valid Snowflake host`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			actual, err := parseEunoURI(tt.uri)
+			if tt.expectedErr != "" {
+				if err == nil || err.Error() != tt.expectedErr {
+					t.Fatalf("expected error %q, got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if actual.Namespace != tt.namespace {
+				t.Errorf("expected namespace %q, got %q", tt.namespace, actual.Namespace)
+			}
+			if actual.Base != tt.base {
+				t.Errorf("expected base %q, got %q", tt.base, actual.Base)
+			}
+			if strings.Join(actual.Path, "|") != strings.Join(tt.path, "|") || len(actual.Path) != len(tt.path) {
+				t.Errorf("expected path %q, got %q", tt.path, actual.Path)
+			}
+		})
+	}
+}
+
+func TestSnowflakeURIRoundTrip(t *testing.T) {
+	for _, host := range []string{"myorg-myaccount", "xy12345.us-east-2.aws", "myorg-myaccount.privatelink.snowflakecomputing.com", "xy12345.cn-north-1.snowflakecomputing.cn"} {
+		t.Run(host, func(t *testing.T) {
+			uri, err := snowflakeURI(host, nil, "ANALYTICS", `"My Schema"`, "orders")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			parsed, err := parseEunoURI(uri)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expectedHost, _ := normalizeSnowflakeHost(host)
+			if parsed.Namespace != "snowflake" || parsed.Base != expectedHost || strings.Join(parsed.Path, "|") != "analytics|My Schema|orders" {
+				t.Errorf("unexpected parse result %+v of %q", parsed, uri)
+			}
+		})
+	}
+}
+
+func TestSnowflakeURIFunction(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		overrides   []attr.Value
+		expected    string
+		expectedErr string
+	}{
+		{name: "host", expected: "snowflake.myorg-myaccount.snowflakecomputing.com.analytics.public.orders"},
+		{name: "null override", overrides: []attr.Value{types.StringNull()}, expected: "snowflake.myorg-myaccount.snowflakecomputing.com.analytics.public.orders"},
+		{name: "override", overrides: []attr.Value{types.StringValue("prod")}, expected: "snowflake.prod.analytics.public.orders"},
+		{name: "two overrides", overrides: []attr.Value{types.StringValue("prod"), types.StringValue("dev")}, expectedErr: "override_base_uri can only be given once"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overrideTypes := make([]attr.Type, len(tt.overrides))
+			for i := range tt.overrides {
+				overrideTypes[i] = types.StringType
+			}
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue("MyOrg-MyAccount"),
+					types.StringValue("ANALYTICS"),
+					types.StringValue("public"),
+					types.StringValue("orders"),
+					types.TupleValueMust(overrideTypes, tt.overrides),
+				}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+			NewSnowflakeURIFunction().Run(ctx, req, &resp)
+
+			if tt.expectedErr != "" {
+				if resp.Error == nil || resp.Error.Text != tt.expectedErr {
+					t.Fatalf("expected error %q, got %v", tt.expectedErr, resp.Error)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if !resp.Result.Value().Equal(types.StringValue(tt.expected)) {
+				t.Errorf("expected %q, got %s", tt.expected, resp.Result.Value())
+			}
+		})
+	}
+}
+
+func TestParseURIFunction(t *testing.T) {
+	ctx := context.Background()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("dbt.myproject.model.orders")}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(types.ObjectUnknown(uriAttributeTypes))}
+
+	NewParseURIFunction().Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	expected := types.ObjectValueMust(uriAttributeTypes, map[string]attr.Value{
+		"namespace": types.StringValue("dbt"),
+		"base":      types.StringValue("myproject"),
+		"path":      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("model"), types.StringValue("orders")}),
+	})
+	if !resp.Result.Value().Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, resp.Result.Value())
+	}
+}